@@ -3,6 +3,8 @@ package keycloak
 import (
 	"context"
 	"fmt"
+	"reflect"
+	"sort"
 	"time"
 )
 
@@ -13,6 +15,14 @@ type authenticationExecutionCreate struct {
 	Provider string `json:"provider"` //authenticator of the execution
 }
 
+// used to move an execution on keycloak 25 and newer, which accepts the priority when updating executions.
+// older versions silently ignore the priority, so executions have to be moved using raise-priority and lower-priority instead
+type authenticationExecutionPriorityUpdate struct {
+	Id          string `json:"id"`
+	Requirement string `json:"requirement"`
+	Priority    int    `json:"priority"`
+}
+
 type authenticationExecutionRequirementUpdate struct {
 	RealmId         string `json:"-"`
 	ParentFlowAlias string `json:"-"`
@@ -40,12 +50,15 @@ type AuthenticationExecutionInfo struct {
 	RealmId              string `json:"-"`
 	ParentFlowAlias      string `json:"-"`
 	Alias                string `json:"alias"`
+	DisplayName          string `json:"displayName"`
+	Description          string `json:"description"`
 	AuthenticationConfig string `json:"authenticationConfig"`
 	AuthenticationFlow   bool   `json:"authenticationFlow"`
 	Configurable         bool   `json:"configurable"`
 	FlowId               string `json:"flowId"`
 	Index                int    `json:"index"`
 	Level                int    `json:"level"`
+	Priority             int    `json:"priority"` // only returned by keycloak 25 and newer
 	ProviderId           string `json:"providerId"`
 	Requirement          string `json:"requirement"`
 }
@@ -118,6 +131,26 @@ func (keycloakClient *KeycloakClient) GetAuthenticationExecutionInfoFromProvider
 	return nil, fmt.Errorf("no authentication execution under parent flow alias %s with provider id %s found", parentFlowAlias, providerId)
 }
 
+// GetAuthenticationSubFlowExecutionInfoFromAlias finds the execution that wraps the subflow with the given alias. this is
+// needed to adopt subflows that were created outside of terraform, such as the ones created when copying a flow.
+func (keycloakClient *KeycloakClient) GetAuthenticationSubFlowExecutionInfoFromAlias(ctx context.Context, realmId, parentFlowAlias, alias string) (*AuthenticationExecutionInfo, error) {
+	authenticationExecutions, err := keycloakClient.ListAuthenticationExecutions(ctx, realmId, parentFlowAlias)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, authenticationExecution := range authenticationExecutions {
+		if authenticationExecution.Level == 0 && authenticationExecution.AuthenticationFlow && authenticationExecution.DisplayName == alias {
+			authenticationExecution.RealmId = realmId
+			authenticationExecution.ParentFlowAlias = parentFlowAlias
+
+			return authenticationExecution, nil
+		}
+	}
+
+	return nil, fmt.Errorf("no authentication subflow under parent flow alias %s with alias %s found", parentFlowAlias, alias)
+}
+
 func (keycloakClient *KeycloakClient) NewAuthenticationExecution(ctx context.Context, execution *AuthenticationExecution) error {
 	_, location, err := keycloakClient.post(ctx, fmt.Sprintf("/realms/%s/authentication/flows/%s/executions/execution", execution.RealmId, execution.ParentFlowAlias), &authenticationExecutionCreate{Provider: execution.Authenticator})
 	if err != nil {
@@ -187,3 +220,126 @@ func (keycloakClient *KeycloakClient) LowerAuthenticationExecutionPriority(ctx c
 	}
 	return nil
 }
+
+func (keycloakClient *KeycloakClient) UpdateAuthenticationExecutionPriority(ctx context.Context, realmId, parentFlowAlias string, execution *AuthenticationExecutionInfo, priority int) error {
+	authenticationExecutionPriorityUpdate := &authenticationExecutionPriorityUpdate{
+		Id:          execution.Id,
+		Requirement: execution.Requirement,
+		Priority:    priority,
+	}
+
+	return keycloakClient.put(ctx, fmt.Sprintf("/realms/%s/authentication/flows/%s/executions", realmId, parentFlowAlias), authenticationExecutionPriorityUpdate)
+}
+
+// GetAuthenticationExecutionChildId returns the id that terraform uses for an execution: subflows are identified by the
+// id of the flow they wrap, while every other execution is identified by its own id
+func GetAuthenticationExecutionChildId(execution *AuthenticationExecutionInfo) string {
+	if execution.AuthenticationFlow {
+		return execution.FlowId
+	}
+
+	return execution.Id
+}
+
+// ListAuthenticationFlowChildren returns the executions and subflows that belong directly to the given flow, in the order
+// that keycloak runs them. nested executions are omitted.
+func (keycloakClient *KeycloakClient) ListAuthenticationFlowChildren(ctx context.Context, realmId, parentFlowAlias string) (AuthenticationExecutionList, error) {
+	authenticationExecutions, err := keycloakClient.ListAuthenticationExecutions(ctx, realmId, parentFlowAlias)
+	if err != nil {
+		return nil, err
+	}
+
+	var children AuthenticationExecutionList
+	for _, authenticationExecution := range authenticationExecutions {
+		if authenticationExecution.Level != 0 {
+			continue
+		}
+
+		authenticationExecution.RealmId = realmId
+		authenticationExecution.ParentFlowAlias = parentFlowAlias
+		children = append(children, authenticationExecution)
+	}
+
+	sort.Stable(children)
+
+	return children, nil
+}
+
+// ReorderAuthenticationFlowChildren moves the executions and subflows with the given ids so they run in the given order.
+// children of the flow that are not part of `order` keep their position, and the listed children are moved around them.
+func (keycloakClient *KeycloakClient) ReorderAuthenticationFlowChildren(ctx context.Context, realmId, parentFlowAlias string, order []string) error {
+	children, err := keycloakClient.ListAuthenticationFlowChildren(ctx, realmId, parentFlowAlias)
+	if err != nil {
+		return err
+	}
+
+	childrenById := make(map[string]*AuthenticationExecutionInfo, len(children))
+	currentOrder := make([]string, 0, len(children))
+	for _, child := range children {
+		childId := GetAuthenticationExecutionChildId(child)
+		childrenById[childId] = child
+		currentOrder = append(currentOrder, childId)
+	}
+
+	isOrdered := make(map[string]bool, len(order))
+	for _, childId := range order {
+		if _, ok := childrenById[childId]; !ok {
+			return fmt.Errorf("no authentication execution or subflow with id %s found under parent flow alias %s", childId, parentFlowAlias)
+		}
+		if isOrdered[childId] {
+			return fmt.Errorf("authentication execution or subflow with id %s is listed more than once", childId)
+		}
+		isOrdered[childId] = true
+	}
+
+	targetOrder := make([]string, 0, len(currentOrder))
+	next := 0
+	for _, childId := range currentOrder {
+		if isOrdered[childId] {
+			targetOrder = append(targetOrder, order[next])
+			next++
+		} else {
+			targetOrder = append(targetOrder, childId)
+		}
+	}
+
+	versionOk, err := keycloakClient.VersionIsGreaterThanOrEqualTo(ctx, Version_25)
+	if err != nil {
+		return err
+	}
+
+	if versionOk {
+		if reflect.DeepEqual(currentOrder, targetOrder) {
+			return nil
+		}
+
+		// priorities are only meaningful relative to each other, so every child is given a new one
+		for i, childId := range targetOrder {
+			err = keycloakClient.UpdateAuthenticationExecutionPriority(ctx, realmId, parentFlowAlias, childrenById[childId], (i+1)*10)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	// older versions can only swap an execution with its neighbour, so each child is raised until it reaches its position
+	for i, childId := range targetOrder {
+		j := i
+		for currentOrder[j] != childId {
+			j++
+		}
+
+		for ; j > i; j-- {
+			err = keycloakClient.RaiseAuthenticationExecutionPriority(ctx, realmId, childrenById[childId].Id)
+			if err != nil {
+				return err
+			}
+
+			currentOrder[j], currentOrder[j-1] = currentOrder[j-1], currentOrder[j]
+		}
+	}
+
+	return nil
+}
@@ -3,6 +3,7 @@ package keycloak
 import (
 	"context"
 	"fmt"
+	"net/url"
 	"time"
 )
 
@@ -16,6 +17,12 @@ type AuthenticationFlow struct {
 	BuiltIn     bool   `json:"builtIn"`
 }
 
+// this is only used when copying an existing flow
+// POST /realms/${realmId}/authentication/flows/${flowAlias}/copy
+type authenticationFlowCopy struct {
+	NewName string `json:"newName"`
+}
+
 func (keycloakClient *KeycloakClient) ListAuthenticationFlows(ctx context.Context, realmId string) ([]*AuthenticationFlow, error) {
 	var authenticationFlows []*AuthenticationFlow
 
@@ -44,6 +51,24 @@ func (keycloakClient *KeycloakClient) NewAuthenticationFlow(ctx context.Context,
 	return nil
 }
 
+// CopyAuthenticationFlow duplicates the flow with the alias `fromAlias`, including all of its executions and subflows.
+// the copy keeps the provider id and description of the original flow, and is always created as a top level flow.
+func (keycloakClient *KeycloakClient) CopyAuthenticationFlow(ctx context.Context, authenticationFlow *AuthenticationFlow, fromAlias string) error {
+	_, _, err := keycloakClient.post(ctx, fmt.Sprintf("/realms/%s/authentication/flows/%s/copy", authenticationFlow.RealmId, url.PathEscape(fromAlias)), &authenticationFlowCopy{NewName: authenticationFlow.Alias})
+	if err != nil {
+		return err
+	}
+
+	// the copy endpoint doesn't return a location header on every version, so the new flow has to be looked up by its alias
+	copiedAuthenticationFlow, err := keycloakClient.GetAuthenticationFlowFromAlias(ctx, authenticationFlow.RealmId, authenticationFlow.Alias)
+	if err != nil {
+		return err
+	}
+	authenticationFlow.Id = copiedAuthenticationFlow.Id
+
+	return nil
+}
+
 func (keycloakClient *KeycloakClient) GetAuthenticationFlow(ctx context.Context, realmId, id string) (*AuthenticationFlow, error) {
 	var authenticationFlow AuthenticationFlow
 	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/authentication/flows/%s", realmId, id), &authenticationFlow, nil)
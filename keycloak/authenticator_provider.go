@@ -0,0 +1,243 @@
+package keycloak
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// https://www.keycloak.org/docs-api/latest/rest-api/index.html#_authentication_management
+type AuthenticatorProvider struct {
+	Id          string `json:"id"`
+	DisplayName string `json:"displayName"`
+	Description string `json:"description"`
+}
+
+type AuthenticatorConfigProperty struct {
+	Name         string      `json:"name"`
+	Label        string      `json:"label"`
+	HelpText     string      `json:"helpText"`
+	Type         string      `json:"type"`
+	DefaultValue interface{} `json:"defaultValue"`
+	Options      []string    `json:"options"`
+	Secret       bool        `json:"secret"`
+}
+
+type AuthenticatorConfigDescription struct {
+	Name       string                         `json:"name"`
+	ProviderId string                         `json:"providerId"`
+	HelpText   string                         `json:"helpText"`
+	Properties []*AuthenticatorConfigProperty `json:"properties"`
+}
+
+// the providers that can be used for executions are the same for every realm, and they only change when keycloak is
+// redeployed, so they are fetched once per provider instance
+type authenticatorProviderCache struct {
+	mutex              sync.Mutex
+	providers          []*AuthenticatorProvider
+	configDescriptions map[string]*AuthenticatorConfigDescription
+}
+
+func newAuthenticatorProviderCache() *authenticatorProviderCache {
+	return &authenticatorProviderCache{
+		configDescriptions: make(map[string]*AuthenticatorConfigDescription),
+	}
+}
+
+// ListAuthenticatorProviders returns every provider that can be used as the authenticator of an execution, including
+// client authenticators, form actions, and the forms used by form-flow subflows
+func (keycloakClient *KeycloakClient) ListAuthenticatorProviders(ctx context.Context) ([]*AuthenticatorProvider, error) {
+	cache := keycloakClient.authenticatorProviderCache
+
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	if cache.providers != nil {
+		return cache.providers, nil
+	}
+
+	var providers []*AuthenticatorProvider
+	for _, providerType := range []string{"authenticator-providers", "client-authenticator-providers", "form-action-providers", "form-providers"} {
+		var typeProviders []*AuthenticatorProvider
+
+		err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/authentication/%s", keycloakClient.realm, providerType), &typeProviders, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		providers = append(providers, typeProviders...)
+	}
+
+	cache.providers = providers
+
+	return providers, nil
+}
+
+// GetAuthenticatorConfigDescription returns the description of the config that an authenticator accepts, or nil if the
+// authenticator is not configurable
+func (keycloakClient *KeycloakClient) GetAuthenticatorConfigDescription(ctx context.Context, providerId string) (*AuthenticatorConfigDescription, error) {
+	cache := keycloakClient.authenticatorProviderCache
+
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	if configDescription, ok := cache.configDescriptions[providerId]; ok {
+		return configDescription, nil
+	}
+
+	var configDescription *AuthenticatorConfigDescription
+
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/authentication/config-description/%s", keycloakClient.realm, providerId), &configDescription, nil)
+	if err != nil && !ErrorIs404(err) {
+		return nil, err
+	}
+
+	cache.configDescriptions[providerId] = configDescription
+
+	return configDescription, nil
+}
+
+func (keycloakClient *KeycloakClient) ValidateAuthenticator(ctx context.Context, authenticator string) error {
+	providers, err := keycloakClient.ListAuthenticatorProviders(ctx)
+	if err != nil {
+		return err
+	}
+
+	providerIds := make([]string, 0, len(providers))
+	for _, provider := range providers {
+		if provider.Id == authenticator {
+			return nil
+		}
+
+		providerIds = append(providerIds, provider.Id)
+	}
+
+	return fmt.Errorf("validation error: authenticator %s is not installed on the server%s", authenticator, didYouMean(authenticator, providerIds))
+}
+
+func (keycloakClient *KeycloakClient) ValidateAuthenticatorConfig(ctx context.Context, authenticator string, config map[string]string) error {
+	configDescription, err := keycloakClient.GetAuthenticatorConfigDescription(ctx, authenticator)
+	if err != nil {
+		return err
+	}
+
+	// custom authenticators don't always describe their config, in which case anything is accepted
+	if configDescription == nil || len(configDescription.Properties) == 0 {
+		return nil
+	}
+
+	propertiesByName := make(map[string]*AuthenticatorConfigProperty, len(configDescription.Properties))
+	propertyNames := make([]string, 0, len(configDescription.Properties))
+	for _, property := range configDescription.Properties {
+		propertiesByName[property.Name] = property
+		propertyNames = append(propertyNames, property.Name)
+	}
+
+	keys := make([]string, 0, len(config))
+	for key := range config {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		value := config[key]
+
+		property, ok := propertiesByName[key]
+		if !ok {
+			return fmt.Errorf("validation error: config key %s is not supported by authenticator %s%s", key, authenticator, didYouMean(key, propertyNames))
+		}
+
+		switch property.Type {
+		case "boolean":
+			if value != "true" && value != "false" {
+				return fmt.Errorf("validation error: config key %s of authenticator %s must be either true or false", key, authenticator)
+			}
+		case "Integer":
+			if _, err := strconv.Atoi(value); err != nil {
+				return fmt.Errorf("validation error: config key %s of authenticator %s must be a number", key, authenticator)
+			}
+		case "List":
+			if len(property.Options) != 0 && !contains(property.Options, value) {
+				return fmt.Errorf("validation error: config key %s of authenticator %s must be one of %s%s", key, authenticator, strings.Join(property.Options, ", "), didYouMean(value, property.Options))
+			}
+		case "MultivaluedList":
+			if len(property.Options) == 0 || value == "" {
+				continue
+			}
+
+			for _, v := range strings.Split(value, "##") {
+				if !contains(property.Options, v) {
+					return fmt.Errorf("validation error: config key %s of authenticator %s only accepts the values %s%s", key, authenticator, strings.Join(property.Options, ", "), didYouMean(v, property.Options))
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// didYouMean returns a suggestion for the closest match to `value` within `candidates`, or an empty string if none of
+// them are close enough to be a likely typo
+func didYouMean(value string, candidates []string) string {
+	closest := ""
+	closestDistance := -1
+
+	for _, candidate := range candidates {
+		distance := levenshteinDistance(strings.ToLower(value), strings.ToLower(candidate))
+		if closestDistance == -1 || distance < closestDistance {
+			closest = candidate
+			closestDistance = distance
+		}
+	}
+
+	maxDistance := len(value) / 3
+	if maxDistance < 2 {
+		maxDistance = 2
+	}
+
+	if closestDistance == -1 || closestDistance > maxDistance {
+		return ""
+	}
+
+	return fmt.Sprintf(", did you mean %s?", closest)
+}
+
+func levenshteinDistance(a, b string) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+
+			current[j] = minInt(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+
+		previous, current = current, previous
+	}
+
+	return previous[len(b)]
+}
+
+func minInt(values ...int) int {
+	min := values[0]
+	for _, v := range values[1:] {
+		if v < min {
+			min = v
+		}
+	}
+
+	return min
+}
@@ -70,6 +70,24 @@ func (keycloakClient *KeycloakClient) GetGenericProtocolMapper(ctx context.Conte
 	return &genericProtocolMapper, nil
 }
 
+// ListGenericProtocolMappers returns every protocol mapper of a client or client scope, regardless of its type
+func (keycloakClient *KeycloakClient) ListGenericProtocolMappers(ctx context.Context, realmId, clientId, clientScopeId string) ([]*GenericProtocolMapper, error) {
+	var genericProtocolMappers []*GenericProtocolMapper
+
+	err := keycloakClient.get(ctx, protocolMapperPath(realmId, clientId, clientScopeId), &genericProtocolMappers, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, genericProtocolMapper := range genericProtocolMappers {
+		genericProtocolMapper.ClientId = clientId
+		genericProtocolMapper.ClientScopeId = clientScopeId
+		genericProtocolMapper.RealmId = realmId
+	}
+
+	return genericProtocolMappers, nil
+}
+
 func (keycloakClient *KeycloakClient) UpdateGenericProtocolMapper(ctx context.Context, genericProtocolMapper *GenericProtocolMapper) error {
 	path := individualProtocolMapperPath(genericProtocolMapper.RealmId, genericProtocolMapper.ClientId, genericProtocolMapper.ClientScopeId, genericProtocolMapper.Id)
 
@@ -80,24 +98,30 @@ func (keycloakClient *KeycloakClient) DeleteGenericProtocolMapper(ctx context.Co
 	return keycloakClient.delete(ctx, individualProtocolMapperPath(realmId, clientId, clientScopeId, mapperId), nil)
 }
 
-func (mapper *GenericProtocolMapper) Validate(ctx context.Context, keycloakClient *KeycloakClient) error {
+// Validate checks a new generic protocol mapper, and returns the warnings of ValidateProtocolMapperConfig
+func (mapper *GenericProtocolMapper) Validate(ctx context.Context, keycloakClient *KeycloakClient) ([]string, error) {
 	if mapper.ClientId == "" && mapper.ClientScopeId == "" {
-		return fmt.Errorf("validation error: one of ClientId or ClientScopeId must be set")
+		return nil, fmt.Errorf("validation error: one of ClientId or ClientScopeId must be set")
 	}
 	if mapper.ClientId != "" && mapper.ClientScopeId != "" {
-		return fmt.Errorf("validation error: only one of ClientId or ClientScopeId must be set")
+		return nil, fmt.Errorf("validation error: only one of ClientId or ClientScopeId must be set")
+	}
+
+	warnings, err := keycloakClient.ValidateProtocolMapperConfig(ctx, mapper.Protocol, mapper.ProtocolMapper, mapper.Config)
+	if err != nil {
+		return nil, err
 	}
 
 	protocolMappers, err := keycloakClient.listGenericProtocolMappers(ctx, mapper.RealmId, mapper.ClientId, mapper.ClientScopeId)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	for _, protocolMapper := range protocolMappers {
 		if protocolMapper.Name == mapper.Name {
-			return fmt.Errorf("validation error: a protocol mapper with name %s already exists for this client", mapper.Name)
+			return nil, fmt.Errorf("validation error: a protocol mapper with name %s already exists for this client", mapper.Name)
 		}
 	}
 
-	return nil
+	return warnings, nil
 }
@@ -2,6 +2,7 @@ package keycloak
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"github.com/charlesderek/terraform-w-keycloak/keycloak/types"
 	"reflect"
@@ -92,6 +93,21 @@ func (keycloakClient *KeycloakClient) GetIdentityProvider(ctx context.Context, r
 	return &identityProvider, nil
 }
 
+func (keycloakClient *KeycloakClient) GetIdentityProviders(ctx context.Context, realm string) ([]*IdentityProvider, error) {
+	var identityProviders []*IdentityProvider
+
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/identity-provider/instances", realm), &identityProviders, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, identityProvider := range identityProviders {
+		identityProvider.Realm = realm
+	}
+
+	return identityProviders, nil
+}
+
 func (keycloakClient *KeycloakClient) UpdateIdentityProvider(ctx context.Context, identityProvider *IdentityProvider) error {
 	return keycloakClient.put(ctx, fmt.Sprintf("/realms/%s/identity-provider/instances/%s", identityProvider.Realm, identityProvider.Alias), identityProvider)
 }
@@ -100,6 +116,65 @@ func (keycloakClient *KeycloakClient) DeleteIdentityProvider(ctx context.Context
 	return keycloakClient.delete(ctx, fmt.Sprintf("/realms/%s/identity-provider/instances/%s", realm, alias), nil)
 }
 
+type identityProviderImportConfigRequest struct {
+	ProviderId string `json:"providerId"`
+	FromUrl    string `json:"fromUrl"`
+}
+
+// ImportIdentityProviderConfig asks Keycloak to fetch and parse an OIDC discovery document or SAML metadata descriptor
+// from the given url, returning the identity provider config that Keycloak would use for it
+func (keycloakClient *KeycloakClient) ImportIdentityProviderConfig(ctx context.Context, realm, providerId, fromUrl string) (*IdentityProviderConfig, error) {
+	body, _, err := keycloakClient.post(ctx, fmt.Sprintf("/realms/%s/identity-provider/import-config", realm), &identityProviderImportConfigRequest{
+		ProviderId: providerId,
+		FromUrl:    fromUrl,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var config IdentityProviderConfig
+	err = json.Unmarshal(body, &config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &config, nil
+}
+
+// ImportIdentityProviderConfigFromMetadata is the same as ImportIdentityProviderConfig, but the OIDC discovery document
+// or SAML metadata descriptor is uploaded to Keycloak instead of being fetched by it
+func (keycloakClient *KeycloakClient) ImportIdentityProviderConfigFromMetadata(ctx context.Context, realm, providerId, metadata string) (*IdentityProviderConfig, error) {
+	fields := map[string]string{
+		"providerId": providerId,
+	}
+
+	body, err := keycloakClient.postMultipart(ctx, fmt.Sprintf("/realms/%s/identity-provider/import-config", realm), fields, "file", "metadata", []byte(metadata))
+	if err != nil {
+		return nil, err
+	}
+
+	var config IdentityProviderConfig
+	err = json.Unmarshal(body, &config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &config, nil
+}
+
+func (keycloakClient *KeycloakClient) ValidateSocialIdentityProvider(ctx context.Context, providerId string) error {
+	serverInfo, err := keycloakClient.GetServerInfo(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !serverInfo.providerInstalled("social", providerId) {
+		return fmt.Errorf("validation error: social identity provider \"%s\" does not exist on the server, installed providers: %s", providerId, serverInfo.getInstalledProvidersNames("social"))
+	}
+
+	return nil
+}
+
 func (f *IdentityProviderConfig) UnmarshalJSON(data []byte) error {
 	return unmarshalExtraConfig(data, reflect.ValueOf(f).Elem(), &f.ExtraConfig)
 }
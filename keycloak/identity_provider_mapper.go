@@ -3,22 +3,28 @@ package keycloak
 import (
 	"context"
 	"fmt"
+	"github.com/charlesderek/terraform-w-keycloak/keycloak/types"
 	"reflect"
 )
 
 type IdentityProviderMapperConfig struct {
-	UserAttribute         string                 `json:"user.attribute,omitempty"`
-	UserAttributeName     string                 `json:"userAttribute,omitempty"`
-	Claim                 string                 `json:"claim,omitempty"`
-	ClaimValue            string                 `json:"claim.value,omitempty"`
-	HardcodedAttribute    string                 `json:"attribute,omitempty"`
-	Attribute             string                 `json:"attribute.name,omitempty"`
-	AttributeValue        string                 `json:"attribute.value,omitempty"`
-	AttributeFriendlyName string                 `json:"attribute.friendly.name,omitempty"`
-	Template              string                 `json:"template,omitempty"`
-	Role                  string                 `json:"role,omitempty"`
-	JsonField             string                 `json:"jsonField,omitEmpty"`
-	ExtraConfig           map[string]interface{} `json:"-"`
+	UserAttribute           string                   `json:"user.attribute,omitempty"`
+	UserAttributeName       string                   `json:"userAttribute,omitempty"`
+	Claim                   string                   `json:"claim,omitempty"`
+	ClaimValue              string                   `json:"claim.value,omitempty"`
+	HardcodedAttribute      string                   `json:"attribute,omitempty"`
+	Attribute               string                   `json:"attribute.name,omitempty"`
+	AttributeValue          string                   `json:"attribute.value,omitempty"`
+	AttributeFriendlyName   string                   `json:"attribute.friendly.name,omitempty"`
+	Template                string                   `json:"template,omitempty"`
+	Role                    string                   `json:"role,omitempty"`
+	JsonField               string                   `json:"jsonField,omitEmpty"`
+	Group                   string                   `json:"group,omitempty"`
+	Claims                  string                   `json:"claims,omitempty"`
+	AreClaimValuesRegex     types.KeycloakBoolQuoted `json:"are.claim.values.regex,omitempty"`
+	Attributes              string                   `json:"attributes,omitempty"`
+	AreAttributeValuesRegex types.KeycloakBoolQuoted `json:"are.attribute.values.regex,omitempty"`
+	ExtraConfig             map[string]interface{}   `json:"-"`
 }
 
 type IdentityProviderMapper struct {
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"io/ioutil"
+	"mime/multipart"
 	"net/http"
 	"net/http/cookiejar"
 	"net/url"
@@ -31,9 +32,12 @@ type KeycloakClient struct {
 	initialLogin      bool
 	userAgent         string
 	version           *version.Version
+	serverInfo        *ServerInfo
 	additionalHeaders map[string]string
 	debug             bool
 	redHatSSO         bool
+
+	authenticatorProviderCache *authenticatorProviderCache
 }
 
 type ClientCredentials struct {
@@ -92,6 +96,8 @@ func NewKeycloakClient(ctx context.Context, url, basePath, clientId, clientSecre
 		userAgent:         userAgent,
 		redHatSSO:         redHatSSO,
 		additionalHeaders: additionalHeaders,
+
+		authenticatorProviderCache: newAuthenticatorProviderCache(),
 	}
 
 	if keycloakClient.initialLogin {
@@ -110,17 +116,14 @@ func NewKeycloakClient(ctx context.Context, url, basePath, clientId, clientSecre
 	return &keycloakClient, nil
 }
 
-func (keycloakClient *KeycloakClient) login(ctx context.Context) error {
-	accessTokenUrl := fmt.Sprintf(tokenUrl, keycloakClient.baseUrl, keycloakClient.realm)
-	accessTokenData := keycloakClient.getAuthenticationFormData()
+// sendTokenRequest posts a form to the token endpoint of a realm, using the same http client and headers as every other
+// request. the status code is returned alongside the body, since callers handle failed grants differently.
+func (keycloakClient *KeycloakClient) sendTokenRequest(ctx context.Context, realm string, formData url.Values) (int, []byte, error) {
+	accessTokenUrl := fmt.Sprintf(tokenUrl, keycloakClient.baseUrl, realm)
 
-	tflog.Debug(ctx, "Login request", map[string]interface{}{
-		"request": accessTokenData.Encode(),
-	})
-
-	accessTokenRequest, err := http.NewRequestWithContext(ctx, http.MethodPost, accessTokenUrl, strings.NewReader(accessTokenData.Encode()))
+	accessTokenRequest, err := http.NewRequestWithContext(ctx, http.MethodPost, accessTokenUrl, strings.NewReader(formData.Encode()))
 	if err != nil {
-		return err
+		return 0, nil, err
 	}
 
 	for header, value := range keycloakClient.additionalHeaders {
@@ -135,16 +138,32 @@ func (keycloakClient *KeycloakClient) login(ctx context.Context) error {
 
 	accessTokenResponse, err := keycloakClient.httpClient.Do(accessTokenRequest)
 	if err != nil {
-		return err
-	}
-	if accessTokenResponse.StatusCode != http.StatusOK {
-		return fmt.Errorf("error sending POST request to %s: %s", accessTokenUrl, accessTokenResponse.Status)
+		return 0, nil, err
 	}
 
 	defer accessTokenResponse.Body.Close()
 
 	body, _ := ioutil.ReadAll(accessTokenResponse.Body)
 
+	return accessTokenResponse.StatusCode, body, nil
+}
+
+func (keycloakClient *KeycloakClient) login(ctx context.Context) error {
+	accessTokenUrl := fmt.Sprintf(tokenUrl, keycloakClient.baseUrl, keycloakClient.realm)
+	accessTokenData := keycloakClient.getAuthenticationFormData()
+
+	tflog.Debug(ctx, "Login request", map[string]interface{}{
+		"request": accessTokenData.Encode(),
+	})
+
+	statusCode, body, err := keycloakClient.sendTokenRequest(ctx, keycloakClient.realm, accessTokenData)
+	if err != nil {
+		return err
+	}
+	if statusCode != http.StatusOK {
+		return fmt.Errorf("error sending POST request to %s: %d %s", accessTokenUrl, statusCode, http.StatusText(statusCode))
+	}
+
 	tflog.Debug(ctx, "Login response", map[string]interface{}{
 		"response": string(body),
 	})
@@ -159,11 +178,13 @@ func (keycloakClient *KeycloakClient) login(ctx context.Context) error {
 	keycloakClient.clientCredentials.RefreshToken = clientCredentials.RefreshToken
 	keycloakClient.clientCredentials.TokenType = clientCredentials.TokenType
 
-	info, err := keycloakClient.GetServerInfo(ctx)
+	info, err := keycloakClient.getServerInfo(ctx)
 	if err != nil {
 		return err
 	}
 
+	keycloakClient.serverInfo = info
+
 	serverVersion := info.SystemInfo.ServerVersion
 	if strings.Contains(serverVersion, ".GA") {
 		serverVersion = strings.ReplaceAll(info.SystemInfo.ServerVersion, ".GA", "")
@@ -189,43 +210,23 @@ func (keycloakClient *KeycloakClient) login(ctx context.Context) error {
 }
 
 func (keycloakClient *KeycloakClient) refresh(ctx context.Context) error {
-	refreshTokenUrl := fmt.Sprintf(tokenUrl, keycloakClient.baseUrl, keycloakClient.realm)
 	refreshTokenData := keycloakClient.getAuthenticationFormData()
 
 	tflog.Debug(ctx, "Refresh request", map[string]interface{}{
 		"request": refreshTokenData.Encode(),
 	})
 
-	refreshTokenRequest, err := http.NewRequestWithContext(ctx, http.MethodPost, refreshTokenUrl, strings.NewReader(refreshTokenData.Encode()))
-	if err != nil {
-		return err
-	}
-
-	for header, value := range keycloakClient.additionalHeaders {
-		refreshTokenRequest.Header.Set(header, value)
-	}
-
-	refreshTokenRequest.Header.Set("Content-Type", "application/x-www-form-urlencoded")
-
-	if keycloakClient.userAgent != "" {
-		refreshTokenRequest.Header.Set("User-Agent", keycloakClient.userAgent)
-	}
-
-	refreshTokenResponse, err := keycloakClient.httpClient.Do(refreshTokenRequest)
+	statusCode, body, err := keycloakClient.sendTokenRequest(ctx, keycloakClient.realm, refreshTokenData)
 	if err != nil {
 		return err
 	}
 
-	defer refreshTokenResponse.Body.Close()
-
-	body, _ := ioutil.ReadAll(refreshTokenResponse.Body)
-
 	tflog.Debug(ctx, "Refresh response", map[string]interface{}{
 		"response": string(body),
 	})
 
 	// Handle 401 "User or client no longer has role permissions for client key" until I better understand why that happens in the first place
-	if refreshTokenResponse.StatusCode == http.StatusBadRequest {
+	if statusCode == http.StatusBadRequest {
 		tflog.Debug(ctx, "Unexpected 400, attempting to log in again")
 
 		return keycloakClient.login(ctx)
@@ -264,7 +265,7 @@ func (keycloakClient *KeycloakClient) getAuthenticationFormData() url.Values {
 	return authenticationFormData
 }
 
-func (keycloakClient *KeycloakClient) addRequestHeaders(request *http.Request) {
+func (keycloakClient *KeycloakClient) addRequestHeaders(request *http.Request, authenticated bool) {
 	tokenType := keycloakClient.clientCredentials.TokenType
 	accessToken := keycloakClient.clientCredentials.AccessToken
 
@@ -272,13 +273,20 @@ func (keycloakClient *KeycloakClient) addRequestHeaders(request *http.Request) {
 		request.Header.Set(header, value)
 	}
 
-	request.Header.Set("Authorization", fmt.Sprintf("%s %s", tokenType, accessToken))
+	if authenticated {
+		request.Header.Set("Authorization", fmt.Sprintf("%s %s", tokenType, accessToken))
+	}
 	request.Header.Set("Accept", "application/json")
 
 	if keycloakClient.userAgent != "" {
 		request.Header.Set("User-Agent", keycloakClient.userAgent)
 	}
 
+	// requests such as multipart uploads set their own content type before being sent
+	if request.Header.Get("Content-type") != "" {
+		return
+	}
+
 	if request.Method == http.MethodPost || request.Method == http.MethodPut || request.Method == http.MethodDelete {
 		request.Header.Set("Content-type", "application/json")
 	}
@@ -289,7 +297,13 @@ func (keycloakClient *KeycloakClient) addRequestHeaders(request *http.Request) {
 Sends an HTTP request and refreshes credentials on 403 or 401 errors
 */
 func (keycloakClient *KeycloakClient) sendRequest(ctx context.Context, request *http.Request, body []byte) ([]byte, string, error) {
-	if !keycloakClient.initialLogin {
+	return keycloakClient.doRequest(ctx, request, body, true)
+}
+
+// doRequest sends an HTTP request. unauthenticated requests are sent to public endpoints of keycloak, such as the
+// discovery document of a realm, so they're sent without logging in or refreshing credentials.
+func (keycloakClient *KeycloakClient) doRequest(ctx context.Context, request *http.Request, body []byte, authenticated bool) ([]byte, string, error) {
+	if authenticated && !keycloakClient.initialLogin {
 		keycloakClient.initialLogin = true
 		err := keycloakClient.login(ctx)
 		if err != nil {
@@ -312,7 +326,7 @@ func (keycloakClient *KeycloakClient) sendRequest(ctx context.Context, request *
 
 	tflog.Debug(ctx, "Sending request", requestLogArgs)
 
-	keycloakClient.addRequestHeaders(request)
+	keycloakClient.addRequestHeaders(request, authenticated)
 
 	response, err := keycloakClient.httpClient.Do(request)
 	if err != nil {
@@ -321,7 +335,7 @@ func (keycloakClient *KeycloakClient) sendRequest(ctx context.Context, request *
 
 	// Unauthorized: Token could have expired
 	// Forbidden: After creating a realm, following GETs for the realm return 403 until you refresh
-	if response.StatusCode == http.StatusUnauthorized || response.StatusCode == http.StatusForbidden {
+	if authenticated && (response.StatusCode == http.StatusUnauthorized || response.StatusCode == http.StatusForbidden) {
 		tflog.Debug(ctx, "Got unexpected response, attempting refresh", map[string]interface{}{
 			"status": response.Status,
 		})
@@ -331,7 +345,7 @@ func (keycloakClient *KeycloakClient) sendRequest(ctx context.Context, request *
 			return nil, "", fmt.Errorf("error refreshing credentials: %s", err)
 		}
 
-		keycloakClient.addRequestHeaders(request)
+		keycloakClient.addRequestHeaders(request, authenticated)
 
 		if body != nil {
 			request.Body = ioutil.NopCloser(bytes.NewReader(body))
@@ -403,6 +417,20 @@ func (keycloakClient *KeycloakClient) getRaw(ctx context.Context, path string, p
 	return body, err
 }
 
+// getPublicRaw sends an unauthenticated GET request to a public endpoint of keycloak, such as the discovery document of a
+// realm. these endpoints live outside of /admin, but are requested with the same http client and headers.
+func (keycloakClient *KeycloakClient) getPublicRaw(ctx context.Context, path string) ([]byte, error) {
+	resourceUrl := keycloakClient.baseUrl + path
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, resourceUrl, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, _, err := keycloakClient.doRequest(ctx, request, nil, false)
+	return body, err
+}
+
 func (keycloakClient *KeycloakClient) sendRaw(ctx context.Context, path string, requestBody []byte) ([]byte, error) {
 	resourceUrl := keycloakClient.baseUrl + apiUrl + path
 
@@ -416,6 +444,43 @@ func (keycloakClient *KeycloakClient) sendRaw(ctx context.Context, path string,
 	return body, err
 }
 
+func (keycloakClient *KeycloakClient) postMultipart(ctx context.Context, path string, fields map[string]string, fileField, fileName string, fileContents []byte) ([]byte, error) {
+	resourceUrl := keycloakClient.baseUrl + apiUrl + path
+
+	var payload bytes.Buffer
+	writer := multipart.NewWriter(&payload)
+
+	for k, v := range fields {
+		if err := writer.WriteField(k, v); err != nil {
+			return nil, err
+		}
+	}
+
+	file, err := writer.CreateFormFile(fileField, fileName)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = file.Write(fileContents); err != nil {
+		return nil, err
+	}
+
+	if err = writer.Close(); err != nil {
+		return nil, err
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, resourceUrl, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	request.Header.Set("Content-type", writer.FormDataContentType())
+
+	body, _, err := keycloakClient.sendRequest(ctx, request, payload.Bytes())
+
+	return body, err
+}
+
 func (keycloakClient *KeycloakClient) post(ctx context.Context, path string, requestBody interface{}) ([]byte, string, error) {
 	resourceUrl := keycloakClient.baseUrl + apiUrl + path
 
@@ -0,0 +1,115 @@
+package keycloak
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+)
+
+type OpenIdAllowedOriginsProtocolMapper struct {
+	Id            string
+	Name          string
+	RealmId       string
+	ClientId      string
+	ClientScopeId string
+
+	AddToAccessToken        bool
+	AddToTokenIntrospection bool
+	AddToLightweightClaim   bool
+}
+
+func (mapper *OpenIdAllowedOriginsProtocolMapper) convertToGenericProtocolMapper() *protocolMapper {
+	return &protocolMapper{
+		Id:             mapper.Id,
+		Name:           mapper.Name,
+		Protocol:       "openid-connect",
+		ProtocolMapper: "oidc-allowed-origins-mapper",
+		Config: map[string]string{
+			addToAccessTokenField:        strconv.FormatBool(mapper.AddToAccessToken),
+			addToTokenIntrospectionField: strconv.FormatBool(mapper.AddToTokenIntrospection),
+			addToLightweightClaimField:   strconv.FormatBool(mapper.AddToLightweightClaim),
+		},
+	}
+}
+
+func (protocolMapper *protocolMapper) convertToOpenIdAllowedOriginsProtocolMapper(realmId, clientId, clientScopeId string) (*OpenIdAllowedOriginsProtocolMapper, error) {
+	addToAccessToken, err := parseBoolAndTreatEmptyStringAsFalse(protocolMapper.Config[addToAccessTokenField])
+	if err != nil {
+		return nil, err
+	}
+
+	addToTokenIntrospection, err := parseTokenIntrospectionClaim(protocolMapper.Config, addToAccessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	addToLightweightClaim, err := parseBoolAndTreatEmptyStringAsFalse(protocolMapper.Config[addToLightweightClaimField])
+	if err != nil {
+		return nil, err
+	}
+
+	return &OpenIdAllowedOriginsProtocolMapper{
+		Id:            protocolMapper.Id,
+		Name:          protocolMapper.Name,
+		RealmId:       realmId,
+		ClientId:      clientId,
+		ClientScopeId: clientScopeId,
+
+		AddToAccessToken:        addToAccessToken,
+		AddToTokenIntrospection: addToTokenIntrospection,
+		AddToLightweightClaim:   addToLightweightClaim,
+	}, nil
+}
+
+func (keycloakClient *KeycloakClient) GetOpenIdAllowedOriginsProtocolMapper(ctx context.Context, realmId, clientId, clientScopeId, mapperId string) (*OpenIdAllowedOriginsProtocolMapper, error) {
+	var protocolMapper *protocolMapper
+
+	err := keycloakClient.get(ctx, individualProtocolMapperPath(realmId, clientId, clientScopeId, mapperId), &protocolMapper, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return protocolMapper.convertToOpenIdAllowedOriginsProtocolMapper(realmId, clientId, clientScopeId)
+}
+
+func (keycloakClient *KeycloakClient) DeleteOpenIdAllowedOriginsProtocolMapper(ctx context.Context, realmId, clientId, clientScopeId, mapperId string) error {
+	return keycloakClient.delete(ctx, individualProtocolMapperPath(realmId, clientId, clientScopeId, mapperId), nil)
+}
+
+func (keycloakClient *KeycloakClient) NewOpenIdAllowedOriginsProtocolMapper(ctx context.Context, mapper *OpenIdAllowedOriginsProtocolMapper) error {
+	path := protocolMapperPath(mapper.RealmId, mapper.ClientId, mapper.ClientScopeId)
+
+	_, location, err := keycloakClient.post(ctx, path, mapper.convertToGenericProtocolMapper())
+	if err != nil {
+		return err
+	}
+
+	mapper.Id = getIdFromLocationHeader(location)
+
+	return nil
+}
+
+func (keycloakClient *KeycloakClient) UpdateOpenIdAllowedOriginsProtocolMapper(ctx context.Context, mapper *OpenIdAllowedOriginsProtocolMapper) error {
+	path := individualProtocolMapperPath(mapper.RealmId, mapper.ClientId, mapper.ClientScopeId, mapper.Id)
+
+	return keycloakClient.put(ctx, path, mapper.convertToGenericProtocolMapper())
+}
+
+func (keycloakClient *KeycloakClient) ValidateOpenIdAllowedOriginsProtocolMapper(ctx context.Context, mapper *OpenIdAllowedOriginsProtocolMapper) error {
+	if mapper.ClientId == "" && mapper.ClientScopeId == "" {
+		return fmt.Errorf("validation error: one of ClientId or ClientScopeId must be set")
+	}
+
+	protocolMappers, err := keycloakClient.listGenericProtocolMappers(ctx, mapper.RealmId, mapper.ClientId, mapper.ClientScopeId)
+	if err != nil {
+		return err
+	}
+
+	for _, protocolMapper := range protocolMappers {
+		if protocolMapper.Name == mapper.Name && protocolMapper.Id != mapper.Id {
+			return fmt.Errorf("validation error: a protocol mapper with name %s already exists for this client", mapper.Name)
+		}
+	}
+
+	return nil
+}
@@ -13,8 +13,10 @@ type OpenIdAudienceProtocolMapper struct {
 	ClientId      string
 	ClientScopeId string
 
-	AddToIdToken     bool
-	AddToAccessToken bool
+	AddToIdToken            bool
+	AddToAccessToken        bool
+	AddToTokenIntrospection bool
+	AddToLightweightClaim   bool
 
 	IncludedClientAudience string
 	IncludedCustomAudience string
@@ -27,10 +29,12 @@ func (mapper *OpenIdAudienceProtocolMapper) convertToGenericProtocolMapper() *pr
 		Protocol:       "openid-connect",
 		ProtocolMapper: "oidc-audience-mapper",
 		Config: map[string]string{
-			addToIdTokenField:           strconv.FormatBool(mapper.AddToIdToken),
-			addToAccessTokenField:       strconv.FormatBool(mapper.AddToAccessToken),
-			includedClientAudienceField: mapper.IncludedClientAudience,
-			includedCustomAudienceField: mapper.IncludedCustomAudience,
+			addToIdTokenField:            strconv.FormatBool(mapper.AddToIdToken),
+			addToAccessTokenField:        strconv.FormatBool(mapper.AddToAccessToken),
+			addToTokenIntrospectionField: strconv.FormatBool(mapper.AddToTokenIntrospection),
+			addToLightweightClaimField:   strconv.FormatBool(mapper.AddToLightweightClaim),
+			includedClientAudienceField:  mapper.IncludedClientAudience,
+			includedCustomAudienceField:  mapper.IncludedCustomAudience,
 		},
 	}
 }
@@ -46,6 +50,16 @@ func (protocolMapper *protocolMapper) convertToOpenIdAudienceProtocolMapper(real
 		return nil, err
 	}
 
+	addToTokenIntrospection, err := parseTokenIntrospectionClaim(protocolMapper.Config, addToAccessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	addToLightweightClaim, err := parseBoolAndTreatEmptyStringAsFalse(protocolMapper.Config[addToLightweightClaimField])
+	if err != nil {
+		return nil, err
+	}
+
 	return &OpenIdAudienceProtocolMapper{
 		Id:            protocolMapper.Id,
 		Name:          protocolMapper.Name,
@@ -53,8 +67,10 @@ func (protocolMapper *protocolMapper) convertToOpenIdAudienceProtocolMapper(real
 		ClientId:      clientId,
 		ClientScopeId: clientScopeId,
 
-		AddToIdToken:     addToIdToken,
-		AddToAccessToken: addToAccessToken,
+		AddToIdToken:            addToIdToken,
+		AddToAccessToken:        addToAccessToken,
+		AddToTokenIntrospection: addToTokenIntrospection,
+		AddToLightweightClaim:   addToLightweightClaim,
 
 		IncludedClientAudience: protocolMapper.Config[includedClientAudienceField],
 		IncludedCustomAudience: protocolMapper.Config[includedCustomAudienceField],
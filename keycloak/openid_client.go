@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"github.com/charlesderek/terraform-w-keycloak/keycloak/types"
 	"reflect"
+	"strings"
 )
 
 type OpenidClientRole struct {
@@ -80,6 +81,30 @@ type OpenidClientAttributes struct {
 	Oauth2DeviceCodeLifespan              string                           `json:"oauth2.device.code.lifespan,omitempty"`
 	Oauth2DevicePollingInterval           string                           `json:"oauth2.device.polling.interval,omitempty"`
 	PostLogoutRedirectUris                types.KeycloakSliceHashDelimited `json:"post.logout.redirect.uris,omitempty"`
+	UseJwksUrl                            types.KeycloakBoolQuoted         `json:"use.jwks.url"`
+	JwksUrl                               string                           `json:"jwks.url"`
+	TokenEndpointAuthSigningAlg           string                           `json:"token.endpoint.auth.signing.alg"`
+	TlsClientCertificateBoundAccessTokens types.KeycloakBoolQuoted         `json:"tls.client.certificate.bound.access.tokens"`
+	X509SubjectDn                         string                           `json:"x509.subjectdn"`
+	X509AllowRegexPatternComparison       types.KeycloakBoolQuoted         `json:"x509.allow.regex.pattern.comparison"`
+	RequirePushedAuthorizationRequests    types.KeycloakBoolQuoted         `json:"require.pushed.authorization.requests"`
+	CibaGrantEnabled                      types.KeycloakBoolQuoted         `json:"oidc.ciba.grant.enabled"`
+	CibaBackchannelTokenDeliveryMode      string                           `json:"ciba.backchannel.token.delivery.mode"`
+	CibaBackchannelClientNotificationUrl  string                           `json:"ciba.backchannel.client.notification.endpoint"`
+	DpopBoundAccessTokens                 types.KeycloakBoolQuoted         `json:"dpop.bound.access.tokens"`
+	IdTokenSignedResponseAlg              string                           `json:"id.token.signed.response.alg"`
+	IdTokenEncryptedResponseAlg           string                           `json:"id.token.encrypted.response.alg"`
+	IdTokenEncryptedResponseEnc           string                           `json:"id.token.encrypted.response.enc"`
+	UserInfoResponseSignatureAlg          string                           `json:"user.info.response.signature.alg"`
+	UserInfoEncryptedResponseAlg          string                           `json:"user.info.encrypted.response.alg"`
+	UserInfoEncryptedResponseEnc          string                           `json:"user.info.encrypted.response.enc"`
+	AuthorizationSignedResponseAlg        string                           `json:"authorization.signed.response.alg"`
+	AuthorizationEncryptedResponseAlg     string                           `json:"authorization.encrypted.response.alg"`
+	AuthorizationEncryptedResponseEnc     string                           `json:"authorization.encrypted.response.enc"`
+	AcrLoaMap                             string                           `json:"acr.loa.map"`
+	DefaultAcrValues                      types.KeycloakSliceHashDelimited `json:"default.acr.values"`
+	StandardTokenExchangeEnabled          types.KeycloakBoolQuoted         `json:"standard.token.exchange.enabled"`
+	StandardTokenExchangeRefreshTokenType string                           `json:"standard.token.exchange.enableRefreshRequestedTokenType"`
 }
 
 type OpenidAuthenticationFlowBindingOverrides struct {
@@ -113,6 +138,53 @@ func (keycloakClient *KeycloakClient) ValidateOpenidClient(ctx context.Context,
 		return fmt.Errorf("validation error: service accounts (client credentials flow) cannot be enabled on public clients")
 	}
 
+	if client.ClientAuthenticatorType == "client-x509" && client.Attributes.X509SubjectDn == "" {
+		return fmt.Errorf("validation error: x509 client authentication requires a subject DN")
+	}
+
+	if bool(client.Attributes.UseJwksUrl) && client.Attributes.JwksUrl == "" {
+		return fmt.Errorf("validation error: a JWKS url is required when the client's keys are read from a JWKS url")
+	}
+
+	if signingAlg := client.Attributes.TokenEndpointAuthSigningAlg; signingAlg != "" {
+		hmac := strings.HasPrefix(signingAlg, "HS")
+		if client.ClientAuthenticatorType == "client-secret-jwt" && !hmac {
+			return fmt.Errorf("validation error: client-secret-jwt authentication requires an HMAC signing algorithm, got %s", signingAlg)
+		}
+
+		if client.ClientAuthenticatorType == "client-jwt" && hmac {
+			return fmt.Errorf("validation error: client-jwt authentication requires an asymmetric signing algorithm, got %s", signingAlg)
+		}
+	}
+
+	if client.Attributes.CibaGrantEnabled && client.Attributes.CibaBackchannelTokenDeliveryMode == "ping" && client.Attributes.CibaBackchannelClientNotificationUrl == "" {
+		return fmt.Errorf("validation error: the ping token delivery mode requires a client notification endpoint")
+	}
+
+	if client.Attributes.StandardTokenExchangeRefreshTokenType != "" && !client.Attributes.StandardTokenExchangeEnabled {
+		return fmt.Errorf("validation error: the refresh token behavior of standard token exchange can only be set when standard token exchange is enabled")
+	}
+
+	if bool(client.Attributes.StandardTokenExchangeEnabled) && client.PublicClient {
+		return fmt.Errorf("validation error: standard token exchange cannot be enabled on public clients")
+	}
+
+	encryptedResponses := map[string][2]string{
+		"ID token":               {client.Attributes.IdTokenEncryptedResponseAlg, client.Attributes.IdTokenEncryptedResponseEnc},
+		"user info":              {client.Attributes.UserInfoEncryptedResponseAlg, client.Attributes.UserInfoEncryptedResponseEnc},
+		"authorization response": {client.Attributes.AuthorizationEncryptedResponseAlg, client.Attributes.AuthorizationEncryptedResponseEnc},
+	}
+	for description, algorithms := range encryptedResponses {
+		if algorithms[0] == "" && algorithms[1] != "" {
+			return fmt.Errorf("validation error: the %s content encryption algorithm can only be set along with a key management algorithm", description)
+		}
+	}
+
+	err := keycloakClient.validateOpenidClientVersion(ctx, client)
+	if err != nil {
+		return err
+	}
+
 	serverInfo, err := keycloakClient.GetServerInfo(ctx)
 	if err != nil {
 		return err
@@ -122,6 +194,77 @@ func (keycloakClient *KeycloakClient) ValidateOpenidClient(ctx context.Context,
 		return fmt.Errorf("validation error: theme \"%s\" does not exist on the server", client.Attributes.LoginTheme)
 	}
 
+	return validateOpenidClientAlgorithms(serverInfo, client)
+}
+
+// validateOpenidClientVersion checks that the protocol settings of a client are supported by the server. these are only
+// checked when they're enabled, so the defaults work with every version.
+func (keycloakClient *KeycloakClient) validateOpenidClientVersion(ctx context.Context, client *OpenidClient) error {
+	settings := []struct {
+		description string
+		enabled     bool
+		version     Version
+	}{
+		{"CIBA", bool(client.Attributes.CibaGrantEnabled), Version_13},
+		{"requiring pushed authorization requests", bool(client.Attributes.RequirePushedAuthorizationRequests), Version_15},
+		{"the JWT secured authorization response mode", client.Attributes.AuthorizationSignedResponseAlg != "" || client.Attributes.AuthorizationEncryptedResponseAlg != "", Version_15},
+		{"mapping ACR values to LoA levels", client.Attributes.AcrLoaMap != "", Version_17},
+		{"setting default ACR values", len(client.Attributes.DefaultAcrValues) != 0, Version_21},
+		{"binding access tokens with DPoP", bool(client.Attributes.DpopBoundAccessTokens), Version_23},
+		{"standard token exchange", bool(client.Attributes.StandardTokenExchangeEnabled), Version_26_2},
+	}
+
+	for _, setting := range settings {
+		if !setting.enabled {
+			continue
+		}
+
+		ok, err := keycloakClient.VersionIsGreaterThanOrEqualTo(ctx, setting.version)
+		if err != nil {
+			return err
+		}
+
+		if !ok {
+			return fmt.Errorf("validation error: %s is only supported by Keycloak %s and later", setting.description, setting.version)
+		}
+	}
+
+	return nil
+}
+
+// validateOpenidClientAlgorithms checks the signing and encryption algorithms of a client against the ones the server
+// has providers for
+func validateOpenidClientAlgorithms(serverInfo *ServerInfo, client *OpenidClient) error {
+	type algorithmSetting struct {
+		description  string
+		providerType string
+		algorithm    string
+	}
+
+	algorithms := []algorithmSetting{
+		{"token endpoint auth signing", "signature", client.Attributes.TokenEndpointAuthSigningAlg},
+		{"ID token signature", "signature", client.Attributes.IdTokenSignedResponseAlg},
+		{"ID token key management", "cekmanagement", client.Attributes.IdTokenEncryptedResponseAlg},
+		{"ID token content encryption", "contentencryption", client.Attributes.IdTokenEncryptedResponseEnc},
+		{"user info key management", "cekmanagement", client.Attributes.UserInfoEncryptedResponseAlg},
+		{"user info content encryption", "contentencryption", client.Attributes.UserInfoEncryptedResponseEnc},
+		{"authorization response signature", "signature", client.Attributes.AuthorizationSignedResponseAlg},
+		{"authorization response key management", "cekmanagement", client.Attributes.AuthorizationEncryptedResponseAlg},
+		{"authorization response content encryption", "contentencryption", client.Attributes.AuthorizationEncryptedResponseEnc},
+	}
+
+	// user info responses are the only ones that can be left unsigned
+	if alg := client.Attributes.UserInfoResponseSignatureAlg; alg != "unsigned" {
+		algorithms = append(algorithms, algorithmSetting{"user info signature", "signature", alg})
+	}
+
+	for _, algorithm := range algorithms {
+		err := serverInfo.ValidateAlgorithm(algorithm.description, algorithm.providerType, algorithm.algorithm)
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -227,6 +370,12 @@ func (keycloakClient *KeycloakClient) GetOpenidClientByClientId(ctx context.Cont
 	return &client, nil
 }
 
+// GetOpenidClientInstallationProvider returns the adapter configuration of a client as rendered by one of keycloak's
+// installation providers, such as keycloak-oidc-keycloak-json
+func (keycloakClient *KeycloakClient) GetOpenidClientInstallationProvider(ctx context.Context, realmId, id, providerId string) ([]byte, error) {
+	return keycloakClient.getRaw(ctx, fmt.Sprintf("/realms/%s/clients/%s/installation/providers/%s", realmId, id, providerId), nil)
+}
+
 func (keycloakClient *KeycloakClient) UpdateOpenidClient(ctx context.Context, client *OpenidClient) error {
 	client.Protocol = "openid-connect"
 
@@ -314,6 +463,10 @@ func (keycloakClient *KeycloakClient) attachOpenidClientScopes(ctx context.Conte
 	}
 
 	for _, openidClientScope := range allOpenidClientScopes {
+		if t == "default" && openidClientScope.Attributes.IsDynamicScope {
+			return fmt.Errorf("validation error: scope %s is a dynamic scope, which can only be attached to a client as an optional scope", openidClientScope.Name)
+		}
+
 		for _, attachedClientScope := range attachedClientScopes {
 			if openidClientScope.Id == attachedClientScope.Id {
 				return fmt.Errorf(duplicateScopeAssignmentErrorMessage, attachedClientScope.Name)
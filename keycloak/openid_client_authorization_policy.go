@@ -35,3 +35,18 @@ func (keycloakClient *KeycloakClient) GetClientAuthorizationPolicyByName(ctx con
 	policy.Name = name
 	return &policy, nil
 }
+
+// GetOpenidClientAuthorizationPolicy returns a policy of any type, without its type specific settings
+func (keycloakClient *KeycloakClient) GetOpenidClientAuthorizationPolicy(ctx context.Context, realmId, resourceServerId, policyId string) (*OpenidClientAuthorizationPolicy, error) {
+	policy := OpenidClientAuthorizationPolicy{
+		RealmId:          realmId,
+		ResourceServerId: resourceServerId,
+	}
+
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/clients/%s/authz/resource-server/policy/%s", realmId, resourceServerId, policyId), &policy, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &policy, nil
+}
@@ -0,0 +1,95 @@
+package keycloak
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// the attribute prefix keycloak uses for the keys that clients sign their JWT assertions with
+const OpenidClientJwtCredentialAttribute = "jwt.credential"
+
+// formats accepted by the upload-certificate endpoint
+const (
+	ClientCertificateFormatCertificatePem = "Certificate PEM"
+	ClientCertificateFormatPublicKeyPem   = "Public Key PEM"
+	ClientCertificateFormatJwks           = "JSON Web Key Set"
+)
+
+type ClientCertificate struct {
+	PrivateKey  string `json:"privateKey,omitempty"`
+	PublicKey   string `json:"publicKey,omitempty"`
+	Certificate string `json:"certificate,omitempty"`
+	Kid         string `json:"kid,omitempty"`
+}
+
+func (keycloakClient *KeycloakClient) GetClientCertificate(ctx context.Context, realmId, clientId, attribute string) (*ClientCertificate, error) {
+	var certificate ClientCertificate
+
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/clients/%s/certificates/%s", realmId, clientId, attribute), &certificate, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &certificate, nil
+}
+
+// GenerateClientCertificate has keycloak generate a new key pair and self signed certificate for the client. this is
+// the only time the private key is returned.
+func (keycloakClient *KeycloakClient) GenerateClientCertificate(ctx context.Context, realmId, clientId, attribute string) (*ClientCertificate, error) {
+	body, _, err := keycloakClient.post(ctx, fmt.Sprintf("/realms/%s/clients/%s/certificates/%s/generate", realmId, clientId, attribute), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var certificate ClientCertificate
+
+	err = json.Unmarshal(body, &certificate)
+	if err != nil {
+		return nil, err
+	}
+
+	return &certificate, nil
+}
+
+// UploadClientCertificate stores a certificate, public key or JWKS for the client. keycloak doesn't need the private key,
+// since it only verifies the assertions signed by the client.
+func (keycloakClient *KeycloakClient) UploadClientCertificate(ctx context.Context, realmId, clientId, attribute, format, value string) (*ClientCertificate, error) {
+	fields := map[string]string{
+		"keystoreFormat": format,
+	}
+
+	body, err := keycloakClient.postMultipart(ctx, fmt.Sprintf("/realms/%s/clients/%s/certificates/%s/upload-certificate", realmId, clientId, attribute), fields, "file", "certificate", []byte(value))
+	if err != nil {
+		return nil, err
+	}
+
+	var certificate ClientCertificate
+
+	err = json.Unmarshal(body, &certificate)
+	if err != nil {
+		return nil, err
+	}
+
+	return &certificate, nil
+}
+
+// DeleteClientCertificate removes the key material stored under the given attribute prefix. keycloak has no endpoint for
+// this, so the attributes are cleared instead. only these attributes are sent, which keeps the rest of the client as it
+// is, since keycloak ignores the fields that are missing from the representation.
+func (keycloakClient *KeycloakClient) DeleteClientCertificate(ctx context.Context, realmId, clientId, attribute string) error {
+	attributes := map[string]string{}
+
+	// keycloak removes attributes that are set to an empty string
+	for _, suffix := range []string{"certificate", "public.key", "kid"} {
+		attributes[attribute+"."+suffix] = ""
+	}
+
+	client := struct {
+		Attributes map[string]string `json:"attributes"`
+	}{
+		Attributes: attributes,
+	}
+
+	return keycloakClient.put(ctx, fmt.Sprintf("/realms/%s/clients/%s", realmId, clientId), client)
+}
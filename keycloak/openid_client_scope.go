@@ -17,11 +17,61 @@ type OpenidClientScope struct {
 		ConsentScreenText      string                   `json:"consent.screen.text"`
 		GuiOrder               string                   `json:"gui.order"`
 		IncludeInTokenScope    types.KeycloakBoolQuoted `json:"include.in.token.scope"` // boolean in string form
+		IsDynamicScope         types.KeycloakBoolQuoted `json:"is.dynamic.scope"`       // boolean in string form
+		DynamicScopeRegexp     string                   `json:"dynamic.scope.regexp"`
 	} `json:"attributes"`
 }
 
+// the feature that needs to be enabled on the server for dynamic scopes to be used
+const dynamicScopesFeature = "DYNAMIC_SCOPES"
+
 type OpenidClientScopeFilterFunc func(*OpenidClientScope) bool
 
+// ValidateOpenidClientScope checks that the server supports dynamic scopes when the client scope is one. keycloak ignores
+// the dynamic scope attributes when the feature is disabled, so this would otherwise go unnoticed.
+func (keycloakClient *KeycloakClient) ValidateOpenidClientScope(ctx context.Context, clientScope *OpenidClientScope) error {
+	if !clientScope.Attributes.IsDynamicScope {
+		return nil
+	}
+
+	ok, err := keycloakClient.VersionIsGreaterThanOrEqualTo(ctx, Version_18)
+	if err != nil {
+		return err
+	}
+
+	if !ok {
+		return fmt.Errorf("validation error: dynamic scopes are only supported by Keycloak %s and later", Version_18)
+	}
+
+	serverInfo, err := keycloakClient.GetServerInfo(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !serverInfo.FeatureIsEnabled(dynamicScopesFeature) {
+		return fmt.Errorf("validation error: client scope %s is a dynamic scope, but the dynamic-scopes feature is not enabled on the server", clientScope.Name)
+	}
+
+	return nil
+}
+
+// ValidateOpenidClientDefaultScopes checks that none of the given client scopes are dynamic scopes, since keycloak only
+// allows these to be attached as optional scopes. client scopes that don't exist yet are skipped.
+func (keycloakClient *KeycloakClient) ValidateOpenidClientDefaultScopes(ctx context.Context, realmId string, scopeNames []string) error {
+	clientScopes, err := keycloakClient.ListOpenidClientScopesWithFilter(ctx, realmId, IncludeOpenidClientScopesMatchingNames(scopeNames))
+	if err != nil {
+		return err
+	}
+
+	for _, clientScope := range clientScopes {
+		if clientScope.Attributes.IsDynamicScope {
+			return fmt.Errorf("validation error: scope %s is a dynamic scope, which can only be attached to a client as an optional scope", clientScope.Name)
+		}
+	}
+
+	return nil
+}
+
 func (keycloakClient *KeycloakClient) NewOpenidClientScope(ctx context.Context, clientScope *OpenidClientScope) error {
 	clientScope.Protocol = "openid-connect"
 
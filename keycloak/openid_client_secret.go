@@ -0,0 +1,88 @@
+package keycloak
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// the client attributes keycloak uses to track the lifetime of client secrets. these are managed by keycloak itself and
+// are never sent back by this provider.
+const (
+	OpenidClientSecretCreationTimeAttribute        = "client.secret.creation.time"
+	OpenidClientSecretExpirationTimeAttribute      = "client.secret.expiration.time"
+	OpenidClientRotatedSecretCreationTimeAttribute = "client.secret.rotated.creation.time"
+	OpenidClientRotatedSecretExpirationAttribute   = "client.secret.rotated.expiration.time"
+)
+
+func (keycloakClient *KeycloakClient) GetOpenidClientSecret(ctx context.Context, realmId, id string) (*OpenidClientSecret, error) {
+	var clientSecret OpenidClientSecret
+
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/clients/%s/client-secret", realmId, id), &clientSecret, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &clientSecret, nil
+}
+
+// RegenerateOpenidClientSecret generates a new secret for a confidential client. when the client is covered by a client
+// policy with the secret-rotation executor, keycloak keeps the previous secret valid as the rotated secret until its
+// grace period ends. otherwise, the previous secret stops working immediately.
+func (keycloakClient *KeycloakClient) RegenerateOpenidClientSecret(ctx context.Context, realmId, id string) (*OpenidClientSecret, error) {
+	body, _, err := keycloakClient.post(ctx, fmt.Sprintf("/realms/%s/clients/%s/client-secret", realmId, id), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var clientSecret OpenidClientSecret
+
+	err = json.Unmarshal(body, &clientSecret)
+	if err != nil {
+		return nil, err
+	}
+
+	return &clientSecret, nil
+}
+
+// GetOpenidClientRotatedSecret returns the previous secret of a client that is still accepted during the grace period
+// of a secret rotation. nil is returned when the client doesn't have a rotated secret, or when the server doesn't
+// support secret rotation.
+func (keycloakClient *KeycloakClient) GetOpenidClientRotatedSecret(ctx context.Context, realmId, id string) (*OpenidClientSecret, error) {
+	if ok, err := keycloakClient.VersionIsGreaterThanOrEqualTo(ctx, Version_19); err != nil {
+		return nil, err
+	} else if !ok {
+		return nil, nil
+	}
+
+	var clientSecret OpenidClientSecret
+
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/clients/%s/client-secret/rotated", realmId, id), &clientSecret, nil)
+	if err != nil {
+		if ErrorIs404(err) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &clientSecret, nil
+}
+
+// GetOpenidClientSecretTime parses one of the client secret timestamps from the attributes of a client. keycloak stores
+// these as seconds since the epoch. the zero time is returned when the attribute isn't set.
+func GetOpenidClientSecretTime(client *OpenidClient, attribute string) time.Time {
+	value, ok := client.Attributes.ExtraConfig[attribute].(string)
+	if !ok || value == "" {
+		return time.Time{}
+	}
+
+	seconds, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || seconds <= 0 {
+		return time.Time{}
+	}
+
+	return time.Unix(seconds, 0).UTC()
+}
@@ -13,9 +13,11 @@ type OpenIdFullNameProtocolMapper struct {
 	ClientId      string
 	ClientScopeId string
 
-	AddToIdToken     bool
-	AddToAccessToken bool
-	AddToUserInfo    bool
+	AddToIdToken            bool
+	AddToAccessToken        bool
+	AddToTokenIntrospection bool
+	AddToLightweightClaim   bool
+	AddToUserInfo           bool
 }
 
 func (mapper *OpenIdFullNameProtocolMapper) convertToGenericProtocolMapper() *protocolMapper {
@@ -25,9 +27,11 @@ func (mapper *OpenIdFullNameProtocolMapper) convertToGenericProtocolMapper() *pr
 		Protocol:       "openid-connect",
 		ProtocolMapper: "oidc-full-name-mapper",
 		Config: map[string]string{
-			addToIdTokenField:     strconv.FormatBool(mapper.AddToIdToken),
-			addToAccessTokenField: strconv.FormatBool(mapper.AddToAccessToken),
-			addToUserInfoField:    strconv.FormatBool(mapper.AddToUserInfo),
+			addToIdTokenField:            strconv.FormatBool(mapper.AddToIdToken),
+			addToAccessTokenField:        strconv.FormatBool(mapper.AddToAccessToken),
+			addToTokenIntrospectionField: strconv.FormatBool(mapper.AddToTokenIntrospection),
+			addToLightweightClaimField:   strconv.FormatBool(mapper.AddToLightweightClaim),
+			addToUserInfoField:           strconv.FormatBool(mapper.AddToUserInfo),
 		},
 	}
 }
@@ -43,6 +47,16 @@ func (protocolMapper *protocolMapper) convertToOpenIdFullNameProtocolMapper(real
 		return nil, err
 	}
 
+	addToTokenIntrospection, err := parseTokenIntrospectionClaim(protocolMapper.Config, accessTokenClaim)
+	if err != nil {
+		return nil, err
+	}
+
+	addToLightweightClaim, err := parseBoolAndTreatEmptyStringAsFalse(protocolMapper.Config[addToLightweightClaimField])
+	if err != nil {
+		return nil, err
+	}
+
 	userinfoTokenClaim, err := parseBoolAndTreatEmptyStringAsFalse(protocolMapper.Config[addToUserInfoField])
 	if err != nil {
 		return nil, err
@@ -55,9 +69,11 @@ func (protocolMapper *protocolMapper) convertToOpenIdFullNameProtocolMapper(real
 		ClientId:      clientId,
 		ClientScopeId: clientScopeId,
 
-		AddToIdToken:     idTokenClaim,
-		AddToAccessToken: accessTokenClaim,
-		AddToUserInfo:    userinfoTokenClaim,
+		AddToIdToken:            idTokenClaim,
+		AddToAccessToken:        accessTokenClaim,
+		AddToTokenIntrospection: addToTokenIntrospection,
+		AddToLightweightClaim:   addToLightweightClaim,
+		AddToUserInfo:           userinfoTokenClaim,
 	}, nil
 }
 
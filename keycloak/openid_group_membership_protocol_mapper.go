@@ -13,9 +13,11 @@ type OpenIdGroupMembershipProtocolMapper struct {
 	ClientId      string
 	ClientScopeId string
 
-	AddToIdToken     bool
-	AddToAccessToken bool
-	AddToUserinfo    bool
+	AddToIdToken            bool
+	AddToAccessToken        bool
+	AddToTokenIntrospection bool
+	AddToLightweightClaim   bool
+	AddToUserinfo           bool
 
 	ClaimName string
 	FullPath  bool
@@ -28,11 +30,13 @@ func (mapper *OpenIdGroupMembershipProtocolMapper) convertToGenericProtocolMappe
 		Protocol:       "openid-connect",
 		ProtocolMapper: "oidc-group-membership-mapper",
 		Config: map[string]string{
-			fullPathField:         strconv.FormatBool(mapper.FullPath),
-			addToIdTokenField:     strconv.FormatBool(mapper.AddToIdToken),
-			addToAccessTokenField: strconv.FormatBool(mapper.AddToAccessToken),
-			addToUserInfoField:    strconv.FormatBool(mapper.AddToUserinfo),
-			claimNameField:        mapper.ClaimName,
+			fullPathField:                strconv.FormatBool(mapper.FullPath),
+			addToIdTokenField:            strconv.FormatBool(mapper.AddToIdToken),
+			addToAccessTokenField:        strconv.FormatBool(mapper.AddToAccessToken),
+			addToTokenIntrospectionField: strconv.FormatBool(mapper.AddToTokenIntrospection),
+			addToLightweightClaimField:   strconv.FormatBool(mapper.AddToLightweightClaim),
+			addToUserInfoField:           strconv.FormatBool(mapper.AddToUserinfo),
+			claimNameField:               mapper.ClaimName,
 		},
 	}
 }
@@ -53,6 +57,16 @@ func (protocolMapper *protocolMapper) convertToOpenIdGroupMembershipProtocolMapp
 		return nil, err
 	}
 
+	addToTokenIntrospection, err := parseTokenIntrospectionClaim(protocolMapper.Config, accessTokenClaim)
+	if err != nil {
+		return nil, err
+	}
+
+	addToLightweightClaim, err := parseBoolAndTreatEmptyStringAsFalse(protocolMapper.Config[addToLightweightClaimField])
+	if err != nil {
+		return nil, err
+	}
+
 	userinfoTokenClaim, err := parseBoolAndTreatEmptyStringAsFalse(protocolMapper.Config[addToUserInfoField])
 	if err != nil {
 		return nil, err
@@ -65,11 +79,13 @@ func (protocolMapper *protocolMapper) convertToOpenIdGroupMembershipProtocolMapp
 		ClientId:      clientId,
 		ClientScopeId: clientScopeId,
 
-		ClaimName:        protocolMapper.Config[claimNameField],
-		FullPath:         fullPath,
-		AddToIdToken:     idTokenClaim,
-		AddToAccessToken: accessTokenClaim,
-		AddToUserinfo:    userinfoTokenClaim,
+		ClaimName:               protocolMapper.Config[claimNameField],
+		FullPath:                fullPath,
+		AddToIdToken:            idTokenClaim,
+		AddToAccessToken:        accessTokenClaim,
+		AddToTokenIntrospection: addToTokenIntrospection,
+		AddToLightweightClaim:   addToLightweightClaim,
+		AddToUserinfo:           userinfoTokenClaim,
 	}, nil
 }
 
@@ -13,9 +13,11 @@ type OpenIdHardcodedClaimProtocolMapper struct {
 	ClientId      string
 	ClientScopeId string
 
-	AddToIdToken     bool
-	AddToAccessToken bool
-	AddToUserInfo    bool
+	AddToIdToken            bool
+	AddToAccessToken        bool
+	AddToTokenIntrospection bool
+	AddToLightweightClaim   bool
+	AddToUserInfo           bool
 
 	ClaimName      string
 	ClaimValue     string
@@ -29,12 +31,14 @@ func (mapper *OpenIdHardcodedClaimProtocolMapper) convertToGenericProtocolMapper
 		Protocol:       "openid-connect",
 		ProtocolMapper: "oidc-hardcoded-claim-mapper",
 		Config: map[string]string{
-			addToIdTokenField:     strconv.FormatBool(mapper.AddToIdToken),
-			addToAccessTokenField: strconv.FormatBool(mapper.AddToAccessToken),
-			addToUserInfoField:    strconv.FormatBool(mapper.AddToUserInfo),
-			claimNameField:        mapper.ClaimName,
-			claimValueField:       mapper.ClaimValue,
-			claimValueTypeField:   mapper.ClaimValueType,
+			addToIdTokenField:            strconv.FormatBool(mapper.AddToIdToken),
+			addToAccessTokenField:        strconv.FormatBool(mapper.AddToAccessToken),
+			addToTokenIntrospectionField: strconv.FormatBool(mapper.AddToTokenIntrospection),
+			addToLightweightClaimField:   strconv.FormatBool(mapper.AddToLightweightClaim),
+			addToUserInfoField:           strconv.FormatBool(mapper.AddToUserInfo),
+			claimNameField:               mapper.ClaimName,
+			claimValueField:              mapper.ClaimValue,
+			claimValueTypeField:          mapper.ClaimValueType,
 		},
 	}
 }
@@ -50,6 +54,16 @@ func (protocolMapper *protocolMapper) convertToOpenIdHardcodedClaimProtocolMappe
 		return nil, err
 	}
 
+	addToTokenIntrospection, err := parseTokenIntrospectionClaim(protocolMapper.Config, addToAccessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	addToLightweightClaim, err := parseBoolAndTreatEmptyStringAsFalse(protocolMapper.Config[addToLightweightClaimField])
+	if err != nil {
+		return nil, err
+	}
+
 	addToUserInfo, err := parseBoolAndTreatEmptyStringAsFalse(protocolMapper.Config[addToUserInfoField])
 	if err != nil {
 		return nil, err
@@ -62,9 +76,11 @@ func (protocolMapper *protocolMapper) convertToOpenIdHardcodedClaimProtocolMappe
 		ClientId:      clientId,
 		ClientScopeId: clientScopeId,
 
-		AddToIdToken:     addToIdToken,
-		AddToAccessToken: addToAccessToken,
-		AddToUserInfo:    addToUserInfo,
+		AddToIdToken:            addToIdToken,
+		AddToAccessToken:        addToAccessToken,
+		AddToTokenIntrospection: addToTokenIntrospection,
+		AddToLightweightClaim:   addToLightweightClaim,
+		AddToUserInfo:           addToUserInfo,
 
 		ClaimName:      protocolMapper.Config[claimNameField],
 		ClaimValue:     protocolMapper.Config[claimValueField],
@@ -0,0 +1,134 @@
+package keycloak
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+var (
+	sectorIdentifierUriField      = "sectorIdentifierUri"
+	pairwiseSubAlgorithmSaltField = "pairwiseSubAlgorithmSalt"
+)
+
+type OpenIdPairwiseSubjectProtocolMapper struct {
+	Id       string
+	Name     string
+	RealmId  string
+	ClientId string
+
+	SectorIdentifierUri      string
+	PairwiseSubAlgorithmSalt string
+}
+
+func (mapper *OpenIdPairwiseSubjectProtocolMapper) convertToGenericProtocolMapper() *protocolMapper {
+	return &protocolMapper{
+		Id:             mapper.Id,
+		Name:           mapper.Name,
+		Protocol:       "openid-connect",
+		ProtocolMapper: "oidc-sha256-pairwise-sub-mapper",
+		Config: map[string]string{
+			sectorIdentifierUriField:      mapper.SectorIdentifierUri,
+			pairwiseSubAlgorithmSaltField: mapper.PairwiseSubAlgorithmSalt,
+		},
+	}
+}
+
+func (protocolMapper *protocolMapper) convertToOpenIdPairwiseSubjectProtocolMapper(realmId, clientId string) *OpenIdPairwiseSubjectProtocolMapper {
+	return &OpenIdPairwiseSubjectProtocolMapper{
+		Id:       protocolMapper.Id,
+		Name:     protocolMapper.Name,
+		RealmId:  realmId,
+		ClientId: clientId,
+
+		SectorIdentifierUri:      protocolMapper.Config[sectorIdentifierUriField],
+		PairwiseSubAlgorithmSalt: protocolMapper.Config[pairwiseSubAlgorithmSaltField],
+	}
+}
+
+func (keycloakClient *KeycloakClient) GetOpenIdPairwiseSubjectProtocolMapper(ctx context.Context, realmId, clientId, mapperId string) (*OpenIdPairwiseSubjectProtocolMapper, error) {
+	var protocolMapper *protocolMapper
+
+	err := keycloakClient.get(ctx, individualProtocolMapperPath(realmId, clientId, "", mapperId), &protocolMapper, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return protocolMapper.convertToOpenIdPairwiseSubjectProtocolMapper(realmId, clientId), nil
+}
+
+func (keycloakClient *KeycloakClient) DeleteOpenIdPairwiseSubjectProtocolMapper(ctx context.Context, realmId, clientId, mapperId string) error {
+	return keycloakClient.delete(ctx, individualProtocolMapperPath(realmId, clientId, "", mapperId), nil)
+}
+
+// NewOpenIdPairwiseSubjectProtocolMapper creates the mapper. keycloak generates a salt when none is provided, which is
+// read back into the mapper.
+func (keycloakClient *KeycloakClient) NewOpenIdPairwiseSubjectProtocolMapper(ctx context.Context, mapper *OpenIdPairwiseSubjectProtocolMapper) error {
+	path := protocolMapperPath(mapper.RealmId, mapper.ClientId, "")
+
+	_, location, err := keycloakClient.post(ctx, path, mapper.convertToGenericProtocolMapper())
+	if err != nil {
+		return err
+	}
+
+	mapper.Id = getIdFromLocationHeader(location)
+
+	return nil
+}
+
+func (keycloakClient *KeycloakClient) UpdateOpenIdPairwiseSubjectProtocolMapper(ctx context.Context, mapper *OpenIdPairwiseSubjectProtocolMapper) error {
+	path := individualProtocolMapperPath(mapper.RealmId, mapper.ClientId, "", mapper.Id)
+
+	return keycloakClient.put(ctx, path, mapper.convertToGenericProtocolMapper())
+}
+
+// ValidateOpenIdPairwiseSubjectProtocolMapper runs the checks keycloak does on the sector identifier, so that they fail
+// with a clear message. keycloak additionally fetches the sector identifier document and checks that it lists all
+// redirect uris of the client.
+func (keycloakClient *KeycloakClient) ValidateOpenIdPairwiseSubjectProtocolMapper(ctx context.Context, mapper *OpenIdPairwiseSubjectProtocolMapper) error {
+	if mapper.ClientId == "" {
+		return fmt.Errorf("validation error: pairwise subject identifiers can only be configured for clients")
+	}
+
+	protocolMappers, err := keycloakClient.listGenericProtocolMappers(ctx, mapper.RealmId, mapper.ClientId, "")
+	if err != nil {
+		return err
+	}
+
+	for _, protocolMapper := range protocolMappers {
+		if protocolMapper.Name == mapper.Name && protocolMapper.Id != mapper.Id {
+			return fmt.Errorf("validation error: a protocol mapper with name %s already exists for this client", mapper.Name)
+		}
+	}
+
+	if mapper.SectorIdentifierUri != "" {
+		sectorIdentifierUri, err := url.Parse(mapper.SectorIdentifierUri)
+		if err != nil || sectorIdentifierUri.Scheme != "https" || sectorIdentifierUri.Host == "" {
+			return fmt.Errorf("validation error: the sector identifier uri %s must be an absolute https url", mapper.SectorIdentifierUri)
+		}
+
+		return nil
+	}
+
+	// without a sector identifier, the host of the redirect uris is used as the sector, so there can only be one
+	client, err := keycloakClient.GetOpenidClient(ctx, mapper.RealmId, mapper.ClientId)
+	if err != nil {
+		return err
+	}
+
+	hosts := make(map[string]bool)
+	for _, redirectUri := range client.ValidRedirectUris {
+		parsed, err := url.Parse(redirectUri)
+		if err != nil {
+			continue
+		}
+
+		hosts[parsed.Host] = true
+	}
+
+	if len(hosts) > 1 {
+		return fmt.Errorf("validation error: the redirect uris of client %s have multiple hosts, which requires a sector identifier uri", client.ClientId)
+	}
+
+	return nil
+}
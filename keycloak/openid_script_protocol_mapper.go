@@ -13,9 +13,11 @@ type OpenIdScriptProtocolMapper struct {
 	ClientId      string
 	ClientScopeId string
 
-	AddToIdToken     bool
-	AddToAccessToken bool
-	AddToUserInfo    bool
+	AddToIdToken            bool
+	AddToAccessToken        bool
+	AddToTokenIntrospection bool
+	AddToLightweightClaim   bool
+	AddToUserInfo           bool
 
 	Script         string
 	ClaimName      string
@@ -31,13 +33,15 @@ func (mapper *OpenIdScriptProtocolMapper) convertToGenericProtocolMapper() *prot
 		Protocol:       "openid-connect",
 		ProtocolMapper: "oidc-script-based-protocol-mapper",
 		Config: map[string]string{
-			addToIdTokenField:     strconv.FormatBool(mapper.AddToIdToken),
-			addToAccessTokenField: strconv.FormatBool(mapper.AddToAccessToken),
-			addToUserInfoField:    strconv.FormatBool(mapper.AddToUserInfo),
-			scriptField:           mapper.Script,
-			claimNameField:        mapper.ClaimName,
-			claimValueTypeField:   mapper.ClaimValueType,
-			multivaluedField:      strconv.FormatBool(mapper.Multivalued),
+			addToIdTokenField:            strconv.FormatBool(mapper.AddToIdToken),
+			addToAccessTokenField:        strconv.FormatBool(mapper.AddToAccessToken),
+			addToTokenIntrospectionField: strconv.FormatBool(mapper.AddToTokenIntrospection),
+			addToLightweightClaimField:   strconv.FormatBool(mapper.AddToLightweightClaim),
+			addToUserInfoField:           strconv.FormatBool(mapper.AddToUserInfo),
+			scriptField:                  mapper.Script,
+			claimNameField:               mapper.ClaimName,
+			claimValueTypeField:          mapper.ClaimValueType,
+			multivaluedField:             strconv.FormatBool(mapper.Multivalued),
 		},
 	}
 }
@@ -53,6 +57,16 @@ func (protocolMapper *protocolMapper) convertToOpenIdScriptProtocolMapper(realmI
 		return nil, err
 	}
 
+	addToTokenIntrospection, err := parseTokenIntrospectionClaim(protocolMapper.Config, addToAccessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	addToLightweightClaim, err := parseBoolAndTreatEmptyStringAsFalse(protocolMapper.Config[addToLightweightClaimField])
+	if err != nil {
+		return nil, err
+	}
+
 	addToUserInfo, err := parseBoolAndTreatEmptyStringAsFalse(protocolMapper.Config[addToUserInfoField])
 	if err != nil {
 		return nil, err
@@ -71,9 +85,11 @@ func (protocolMapper *protocolMapper) convertToOpenIdScriptProtocolMapper(realmI
 		ClientId:      clientId,
 		ClientScopeId: clientScopeId,
 
-		AddToIdToken:     addToIdToken,
-		AddToAccessToken: addToAccessToken,
-		AddToUserInfo:    addToUserInfo,
+		AddToIdToken:            addToIdToken,
+		AddToAccessToken:        addToAccessToken,
+		AddToTokenIntrospection: addToTokenIntrospection,
+		AddToLightweightClaim:   addToLightweightClaim,
+		AddToUserInfo:           addToUserInfo,
 
 		Script:         protocolMapper.Config[scriptField],
 		ClaimName:      protocolMapper.Config[claimNameField],
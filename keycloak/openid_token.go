@@ -0,0 +1,114 @@
+package keycloak
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+const (
+	OpenidTokenGrantTypeClientCredentials = "client_credentials"
+	OpenidTokenGrantTypePassword          = "password"
+	OpenidTokenGrantTypeTokenExchange     = "urn:ietf:params:oauth:grant-type:token-exchange"
+)
+
+type OpenidTokenRequest struct {
+	GrantType    string
+	ClientId     string
+	ClientSecret string
+	Scopes       []string
+
+	// password grant
+	Username string
+	Password string
+
+	// token exchange grant
+	SubjectToken       string
+	SubjectTokenType   string
+	RequestedTokenType string
+	RequestedSubject   string
+	Audience           string
+}
+
+type OpenidToken struct {
+	AccessToken      string `json:"access_token"`
+	RefreshToken     string `json:"refresh_token"`
+	IdToken          string `json:"id_token"`
+	TokenType        string `json:"token_type"`
+	ExpiresIn        int    `json:"expires_in"`
+	RefreshExpiresIn int    `json:"refresh_expires_in"`
+	Scope            string `json:"scope"`
+	IssuedTokenType  string `json:"issued_token_type"`
+}
+
+type openidTokenError struct {
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+func (request *OpenidTokenRequest) formData() url.Values {
+	formData := url.Values{}
+	formData.Set("grant_type", request.GrantType)
+	formData.Set("client_id", request.ClientId)
+
+	if request.ClientSecret != "" {
+		formData.Set("client_secret", request.ClientSecret)
+	}
+
+	if len(request.Scopes) != 0 {
+		formData.Set("scope", strings.Join(request.Scopes, " "))
+	}
+
+	switch request.GrantType {
+	case OpenidTokenGrantTypePassword:
+		formData.Set("username", request.Username)
+		formData.Set("password", request.Password)
+	case OpenidTokenGrantTypeTokenExchange:
+		formData.Set("subject_token", request.SubjectToken)
+
+		if request.SubjectTokenType != "" {
+			formData.Set("subject_token_type", request.SubjectTokenType)
+		}
+		if request.RequestedTokenType != "" {
+			formData.Set("requested_token_type", request.RequestedTokenType)
+		}
+		if request.RequestedSubject != "" {
+			formData.Set("requested_subject", request.RequestedSubject)
+		}
+		if request.Audience != "" {
+			formData.Set("audience", request.Audience)
+		}
+	}
+
+	return formData
+}
+
+// GetOpenidToken requests a token from the token endpoint of a realm on behalf of another client. unlike the token used
+// by the provider itself, it is neither cached nor refreshed.
+func (keycloakClient *KeycloakClient) GetOpenidToken(ctx context.Context, realmId string, request *OpenidTokenRequest) (*OpenidToken, error) {
+	statusCode, body, err := keycloakClient.sendTokenRequest(ctx, realmId, request.formData())
+	if err != nil {
+		return nil, err
+	}
+
+	if statusCode != http.StatusOK {
+		var tokenError openidTokenError
+		if json.Unmarshal(body, &tokenError) == nil && tokenError.Error != "" {
+			return nil, fmt.Errorf("error requesting %s token for client %s: %s: %s", request.GrantType, request.ClientId, tokenError.Error, tokenError.ErrorDescription)
+		}
+
+		return nil, fmt.Errorf("error requesting %s token for client %s: %d %s", request.GrantType, request.ClientId, statusCode, http.StatusText(statusCode))
+	}
+
+	var token OpenidToken
+
+	err = json.Unmarshal(body, &token)
+	if err != nil {
+		return nil, err
+	}
+
+	return &token, nil
+}
@@ -13,9 +13,11 @@ type OpenIdUserAttributeProtocolMapper struct {
 	ClientId      string
 	ClientScopeId string
 
-	AddToIdToken     bool
-	AddToAccessToken bool
-	AddToUserInfo    bool
+	AddToIdToken            bool
+	AddToAccessToken        bool
+	AddToTokenIntrospection bool
+	AddToLightweightClaim   bool
+	AddToUserInfo           bool
 
 	UserAttribute  string
 	ClaimName      string
@@ -34,6 +36,8 @@ func (mapper *OpenIdUserAttributeProtocolMapper) convertToGenericProtocolMapper(
 		Config: map[string]string{
 			addToIdTokenField:             strconv.FormatBool(mapper.AddToIdToken),
 			addToAccessTokenField:         strconv.FormatBool(mapper.AddToAccessToken),
+			addToTokenIntrospectionField:  strconv.FormatBool(mapper.AddToTokenIntrospection),
+			addToLightweightClaimField:    strconv.FormatBool(mapper.AddToLightweightClaim),
 			addToUserInfoField:            strconv.FormatBool(mapper.AddToUserInfo),
 			userAttributeField:            mapper.UserAttribute,
 			claimNameField:                mapper.ClaimName,
@@ -55,6 +59,16 @@ func (protocolMapper *protocolMapper) convertToOpenIdUserAttributeProtocolMapper
 		return nil, err
 	}
 
+	addToTokenIntrospection, err := parseTokenIntrospectionClaim(protocolMapper.Config, addToAccessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	addToLightweightClaim, err := parseBoolAndTreatEmptyStringAsFalse(protocolMapper.Config[addToLightweightClaimField])
+	if err != nil {
+		return nil, err
+	}
+
 	addToUserInfo, err := parseBoolAndTreatEmptyStringAsFalse(protocolMapper.Config[addToUserInfoField])
 	if err != nil {
 		return nil, err
@@ -78,9 +92,11 @@ func (protocolMapper *protocolMapper) convertToOpenIdUserAttributeProtocolMapper
 		ClientId:      clientId,
 		ClientScopeId: clientScopeId,
 
-		AddToIdToken:     addToIdToken,
-		AddToAccessToken: addToAccessToken,
-		AddToUserInfo:    addToUserInfo,
+		AddToIdToken:            addToIdToken,
+		AddToAccessToken:        addToAccessToken,
+		AddToTokenIntrospection: addToTokenIntrospection,
+		AddToLightweightClaim:   addToLightweightClaim,
+		AddToUserInfo:           addToUserInfo,
 
 		UserAttribute:            protocolMapper.Config[userAttributeField],
 		ClaimName:                protocolMapper.Config[claimNameField],
@@ -13,9 +13,11 @@ type OpenIdUserClientRoleProtocolMapper struct {
 	ClientId      string
 	ClientScopeId string
 
-	AddToIdToken     bool
-	AddToAccessToken bool
-	AddToUserInfo    bool
+	AddToIdToken            bool
+	AddToAccessToken        bool
+	AddToTokenIntrospection bool
+	AddToLightweightClaim   bool
+	AddToUserInfo           bool
 
 	ClaimName               string
 	ClaimValueType          string
@@ -31,9 +33,11 @@ func (mapper *OpenIdUserClientRoleProtocolMapper) convertToGenericProtocolMapper
 		Protocol:       "openid-connect",
 		ProtocolMapper: "oidc-usermodel-client-role-mapper",
 		Config: map[string]string{
-			addToIdTokenField:     strconv.FormatBool(mapper.AddToIdToken),
-			addToAccessTokenField: strconv.FormatBool(mapper.AddToAccessToken),
-			addToUserInfoField:    strconv.FormatBool(mapper.AddToUserInfo),
+			addToIdTokenField:            strconv.FormatBool(mapper.AddToIdToken),
+			addToAccessTokenField:        strconv.FormatBool(mapper.AddToAccessToken),
+			addToTokenIntrospectionField: strconv.FormatBool(mapper.AddToTokenIntrospection),
+			addToLightweightClaimField:   strconv.FormatBool(mapper.AddToLightweightClaim),
+			addToUserInfoField:           strconv.FormatBool(mapper.AddToUserInfo),
 
 			claimNameField:                       mapper.ClaimName,
 			claimValueTypeField:                  mapper.ClaimValueType,
@@ -55,6 +59,16 @@ func (protocolMapper *protocolMapper) convertToOpenIdUserClientRoleProtocolMappe
 		return nil, err
 	}
 
+	addToTokenIntrospection, err := parseTokenIntrospectionClaim(protocolMapper.Config, addToAccessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	addToLightweightClaim, err := parseBoolAndTreatEmptyStringAsFalse(protocolMapper.Config[addToLightweightClaimField])
+	if err != nil {
+		return nil, err
+	}
+
 	addToUserInfo, err := parseBoolAndTreatEmptyStringAsFalse(protocolMapper.Config[addToUserInfoField])
 	if err != nil {
 		return nil, err
@@ -72,9 +86,11 @@ func (protocolMapper *protocolMapper) convertToOpenIdUserClientRoleProtocolMappe
 		ClientId:      clientId,
 		ClientScopeId: clientScopeId,
 
-		AddToIdToken:     addToIdToken,
-		AddToAccessToken: addToAccessToken,
-		AddToUserInfo:    addToUserInfo,
+		AddToIdToken:            addToIdToken,
+		AddToAccessToken:        addToAccessToken,
+		AddToTokenIntrospection: addToTokenIntrospection,
+		AddToLightweightClaim:   addToLightweightClaim,
+		AddToUserInfo:           addToUserInfo,
 
 		ClaimName:               protocolMapper.Config[claimNameField],
 		ClaimValueType:          protocolMapper.Config[claimValueTypeField],
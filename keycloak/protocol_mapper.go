@@ -3,6 +3,9 @@ package keycloak
 import (
 	"context"
 	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
 )
 
 // https://www.keycloak.org/docs-api/4.2/rest-api/index.html#_protocolmapperrepresentation
@@ -18,6 +21,8 @@ var (
 	addToAccessTokenField                = "access.token.claim"
 	addToIdTokenField                    = "id.token.claim"
 	addToUserInfoField                   = "userinfo.token.claim"
+	addToTokenIntrospectionField         = "introspection.token.claim"
+	addToLightweightClaimField           = "lightweight.claim"
 	attributeNameField                   = "attribute.name"
 	attributeNameFormatField             = "attribute.nameformat"
 	claimNameField                       = "claim.name"
@@ -38,8 +43,48 @@ var (
 	userClientRoleMappingRolePrefixField = "usermodel.clientRoleMapping.rolePrefix"
 	userSessionNoteField                 = "user.session.note"
 	aggregateAttributeValuesField        = "aggregate.attrs"
+	attributeValueField                  = "attribute.value"
+	newRoleNameField                     = "new.role.name"
+	samlUserSessionNoteField             = "note"
 )
 
+// the name formats keycloak supports for the attributes added by saml protocol mappers
+var samlAttributeNameFormats = []string{"Basic", "URI Reference", "Unspecified"}
+
+// parseTokenIntrospectionClaim reads the introspection.token.claim setting of a mapper. mappers created before keycloak
+// 25 don't have it, in which case keycloak includes the claim in the introspection response when it's in the access token.
+func parseTokenIntrospectionClaim(config map[string]string, addToAccessToken bool) (bool, error) {
+	if config[addToTokenIntrospectionField] == "" {
+		return addToAccessToken, nil
+	}
+
+	return strconv.ParseBool(config[addToTokenIntrospectionField])
+}
+
+// validateSamlAttribute checks the attribute settings shared by the saml protocol mappers. keycloak accepts any value, but
+// service providers reject assertions with an unknown name format, or a name that doesn't match it.
+func validateSamlAttribute(name, friendlyName, nameFormat string) error {
+	if !contains(samlAttributeNameFormats, nameFormat) {
+		return fmt.Errorf("validation error: saml attribute name format must be one of %s", strings.Join(samlAttributeNameFormats, ", "))
+	}
+
+	if strings.TrimSpace(name) == "" {
+		return fmt.Errorf("validation error: saml attribute name must be set")
+	}
+
+	if nameFormat == "URI Reference" {
+		if parsed, err := url.Parse(name); err != nil || parsed.Scheme == "" {
+			return fmt.Errorf("validation error: saml attribute name %s must be an absolute uri when the name format is URI Reference", name)
+		}
+	}
+
+	if friendlyName != "" && strings.TrimSpace(friendlyName) != friendlyName {
+		return fmt.Errorf("validation error: saml attribute friendly name %q must not start or end with whitespace", friendlyName)
+	}
+
+	return nil
+}
+
 func protocolMapperPath(realmId, clientId, clientScopeId string) string {
 	parentResourceId := clientId
 	parentResourcePath := "clients"
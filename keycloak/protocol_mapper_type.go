@@ -0,0 +1,145 @@
+package keycloak
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type ProtocolMapperConfigProperty struct {
+	Name         string      `json:"name"`
+	Label        string      `json:"label"`
+	HelpText     string      `json:"helpText"`
+	Type         string      `json:"type"`
+	DefaultValue interface{} `json:"defaultValue"`
+	Options      []string    `json:"options"`
+	Secret       bool        `json:"secret"`
+}
+
+// ProtocolMapperType describes a protocol mapper that is installed on the server, as reported by the protocolMapperTypes
+// section of /serverinfo
+type ProtocolMapperType struct {
+	Id         string                          `json:"id"`
+	Name       string                          `json:"name"`
+	Category   string                          `json:"category"`
+	HelpText   string                          `json:"helpText"`
+	Priority   int                             `json:"priority"`
+	Properties []*ProtocolMapperConfigProperty `json:"properties"`
+}
+
+// DefaultConfig returns the default value of every property that has one, formatted the way keycloak stores it in the
+// config of a mapper
+func (mapperType *ProtocolMapperType) DefaultConfig() map[string]string {
+	defaults := make(map[string]string)
+
+	for _, property := range mapperType.Properties {
+		switch value := property.DefaultValue.(type) {
+		case string:
+			defaults[property.Name] = value
+		case bool:
+			defaults[property.Name] = strconv.FormatBool(value)
+		case float64:
+			defaults[property.Name] = strconv.FormatFloat(value, 'f', -1, 64)
+		}
+	}
+
+	return defaults
+}
+
+// GetProtocolMapperType returns the protocol mapper type with the given id for a protocol, or nil if the server doesn't
+// report any protocol mapper types for it
+func (keycloakClient *KeycloakClient) GetProtocolMapperType(ctx context.Context, protocol, protocolMapper string) (*ProtocolMapperType, error) {
+	serverInfo, err := keycloakClient.GetServerInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	mapperTypes, ok := serverInfo.ProtocolMapperTypes[protocol]
+	if !ok {
+		return nil, nil
+	}
+
+	mapperTypeIds := make([]string, 0, len(mapperTypes))
+	for _, mapperType := range mapperTypes {
+		if mapperType.Id == protocolMapper {
+			return mapperType, nil
+		}
+
+		mapperTypeIds = append(mapperTypeIds, mapperType.Id)
+	}
+
+	return nil, fmt.Errorf("validation error: protocol mapper %s is not available for protocol %s%s", protocolMapper, protocol, didYouMean(protocolMapper, mapperTypeIds))
+}
+
+// ValidateProtocolMapperConfig checks a protocol mapper and its config against the protocol mapper types reported by the
+// server: the values of known properties must match the property's type. keycloak stores config keys that the mapper
+// doesn't describe, and older servers don't know about keys added later, so these are returned as warnings instead
+func (keycloakClient *KeycloakClient) ValidateProtocolMapperConfig(ctx context.Context, protocol, protocolMapper string, config map[string]string) ([]string, error) {
+	mapperType, err := keycloakClient.GetProtocolMapperType(ctx, protocol, protocolMapper)
+	if err != nil {
+		return nil, err
+	}
+
+	// custom mappers don't always describe their config, in which case anything is accepted
+	if mapperType == nil || len(mapperType.Properties) == 0 {
+		return nil, nil
+	}
+
+	propertiesByName := make(map[string]*ProtocolMapperConfigProperty, len(mapperType.Properties))
+	propertyNames := make([]string, 0, len(mapperType.Properties))
+	for _, property := range mapperType.Properties {
+		propertiesByName[property.Name] = property
+		propertyNames = append(propertyNames, property.Name)
+	}
+
+	keys := make([]string, 0, len(config))
+	for key := range config {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var warnings []string
+	for _, key := range keys {
+		value := config[key]
+
+		property, ok := propertiesByName[key]
+		if !ok {
+			warnings = append(warnings, fmt.Sprintf("config key %s is not supported by protocol mapper %s%s", key, protocolMapper, didYouMean(key, propertyNames)))
+			continue
+		}
+
+		switch property.Type {
+		case "boolean":
+			if value != "true" && value != "false" {
+				return nil, fmt.Errorf("validation error: config key %s of protocol mapper %s must be either true or false", key, protocolMapper)
+			}
+		case "List":
+			if len(property.Options) != 0 && value != "" && !contains(property.Options, value) {
+				return nil, fmt.Errorf("validation error: config key %s of protocol mapper %s must be one of %s%s", key, protocolMapper, strings.Join(property.Options, ", "), didYouMean(value, property.Options))
+			}
+		case "MultivaluedList":
+			if len(property.Options) == 0 || value == "" {
+				continue
+			}
+
+			for _, v := range strings.Split(value, "##") {
+				if !contains(property.Options, v) {
+					return nil, fmt.Errorf("validation error: config key %s of protocol mapper %s only accepts the values %s%s", key, protocolMapper, strings.Join(property.Options, ", "), didYouMean(v, property.Options))
+				}
+			}
+		case "Role":
+			// realm roles are referenced by name, and client roles as {{clientId}}.{{roleName}}
+			if value != "" && (strings.HasPrefix(value, ".") || strings.HasSuffix(value, ".")) {
+				return nil, fmt.Errorf("validation error: config key %s of protocol mapper %s must be a role name, or a client id and role name separated by a dot", key, protocolMapper)
+			}
+		case "Script":
+			if strings.TrimSpace(value) == "" {
+				return nil, fmt.Errorf("validation error: config key %s of protocol mapper %s must not be empty", key, protocolMapper)
+			}
+		}
+	}
+
+	return warnings, nil
+}
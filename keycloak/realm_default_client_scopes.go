@@ -0,0 +1,112 @@
+package keycloak
+
+import (
+	"context"
+	"fmt"
+)
+
+// listClientScopesMatchingNames returns the client scopes with the given names, regardless of their protocol
+func (keycloakClient *KeycloakClient) listClientScopesMatchingNames(ctx context.Context, realmId string, scopeNames []string) ([]*OpenidClientScope, error) {
+	var clientScopes []*OpenidClientScope
+
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/client-scopes", realmId), &clientScopes, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var matchingClientScopes []*OpenidClientScope
+	for _, scopeName := range scopeNames {
+		var matchingClientScope *OpenidClientScope
+		for _, clientScope := range clientScopes {
+			if clientScope.Name == scopeName {
+				matchingClientScope = clientScope
+				break
+			}
+		}
+
+		if matchingClientScope == nil {
+			return nil, fmt.Errorf("validation error: client scope %s does not exist", scopeName)
+		}
+
+		matchingClientScope.RealmId = realmId
+		matchingClientScopes = append(matchingClientScopes, matchingClientScope)
+	}
+
+	return matchingClientScopes, nil
+}
+
+func (keycloakClient *KeycloakClient) attachRealmClientScopes(ctx context.Context, realmId, t string, scopeNames []string) error {
+	if len(scopeNames) == 0 {
+		return nil
+	}
+
+	clientScopes, err := keycloakClient.listClientScopesMatchingNames(ctx, realmId, scopeNames)
+	if err != nil {
+		return err
+	}
+
+	var attachedClientScopes []*OpenidClientScope
+	var duplicateScopeAssignmentErrorMessage string
+	switch t {
+	case "optional":
+		attachedClientScopes, err = keycloakClient.GetRealmDefaultClientScopes(ctx, realmId)
+		duplicateScopeAssignmentErrorMessage = "validation error: scope %s is already a default client scope of realm %s"
+	case "default":
+		attachedClientScopes, err = keycloakClient.GetRealmOptionalClientScopes(ctx, realmId)
+		duplicateScopeAssignmentErrorMessage = "validation error: scope %s is already an optional client scope of realm %s"
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, clientScope := range clientScopes {
+		for _, attachedClientScope := range attachedClientScopes {
+			if clientScope.Id == attachedClientScope.Id {
+				return fmt.Errorf(duplicateScopeAssignmentErrorMessage, attachedClientScope.Name, realmId)
+			}
+		}
+
+		err := keycloakClient.put(ctx, fmt.Sprintf("/realms/%s/default-%s-client-scopes/%s", realmId, t, clientScope.Id), nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (keycloakClient *KeycloakClient) AttachRealmDefaultClientScopes(ctx context.Context, realmId string, scopeNames []string) error {
+	return keycloakClient.attachRealmClientScopes(ctx, realmId, "default", scopeNames)
+}
+
+func (keycloakClient *KeycloakClient) AttachRealmOptionalClientScopes(ctx context.Context, realmId string, scopeNames []string) error {
+	return keycloakClient.attachRealmClientScopes(ctx, realmId, "optional", scopeNames)
+}
+
+func (keycloakClient *KeycloakClient) detachRealmClientScopes(ctx context.Context, realmId, t string, scopeNames []string) error {
+	attachedClientScopes, err := keycloakClient.getRealmClientScopes(ctx, realmId, t)
+	if err != nil {
+		return err
+	}
+
+	for _, attachedClientScope := range attachedClientScopes {
+		if !contains(scopeNames, attachedClientScope.Name) {
+			continue
+		}
+
+		err := keycloakClient.delete(ctx, fmt.Sprintf("/realms/%s/default-%s-client-scopes/%s", realmId, t, attachedClientScope.Id), nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (keycloakClient *KeycloakClient) DetachRealmDefaultClientScopes(ctx context.Context, realmId string, scopeNames []string) error {
+	return keycloakClient.detachRealmClientScopes(ctx, realmId, "default", scopeNames)
+}
+
+func (keycloakClient *KeycloakClient) DetachRealmOptionalClientScopes(ctx context.Context, realmId string, scopeNames []string) error {
+	return keycloakClient.detachRealmClientScopes(ctx, realmId, "optional", scopeNames)
+}
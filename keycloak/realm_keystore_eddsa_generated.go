@@ -0,0 +1,107 @@
+package keycloak
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+)
+
+type RealmKeystoreEddsaGenerated struct {
+	Id      string
+	Name    string
+	RealmId string
+
+	Active        bool
+	Enabled       bool
+	Priority      int
+	EllipticCurve string
+}
+
+func convertFromRealmKeystoreEddsaGeneratedToComponent(realmKey *RealmKeystoreEddsaGenerated) *component {
+	componentConfig := map[string][]string{
+		"active": {
+			strconv.FormatBool(realmKey.Active),
+		},
+		"enabled": {
+			strconv.FormatBool(realmKey.Enabled),
+		},
+		"priority": {
+			strconv.Itoa(realmKey.Priority),
+		},
+		"eddsaEllipticCurveKey": {
+			realmKey.EllipticCurve,
+		},
+	}
+
+	return &component{
+		Id:           realmKey.Id,
+		Name:         realmKey.Name,
+		ParentId:     realmKey.RealmId,
+		ProviderId:   "eddsa-generated",
+		ProviderType: "org.keycloak.keys.KeyProvider",
+		Config:       componentConfig,
+	}
+}
+
+func convertFromComponentToRealmKeystoreEddsaGenerated(component *component, realmId string) (*RealmKeystoreEddsaGenerated, error) {
+	active, err := parseBoolAndTreatEmptyStringAsFalse(component.getConfig("active"))
+	if err != nil {
+		return nil, err
+	}
+
+	enabled, err := parseBoolAndTreatEmptyStringAsFalse(component.getConfig("enabled"))
+	if err != nil {
+		return nil, err
+	}
+
+	priority := 0 // Default priority
+	if component.getConfig("priority") != "" {
+		priority, err = strconv.Atoi(component.getConfig("priority"))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	realmKey := &RealmKeystoreEddsaGenerated{
+		Id:      component.Id,
+		Name:    component.Name,
+		RealmId: realmId,
+
+		Active:        active,
+		Enabled:       enabled,
+		Priority:      priority,
+		EllipticCurve: component.getConfig("eddsaEllipticCurveKey"),
+	}
+
+	return realmKey, nil
+}
+
+func (keycloakClient *KeycloakClient) NewRealmKeystoreEddsaGenerated(ctx context.Context, realmKey *RealmKeystoreEddsaGenerated) error {
+	_, location, err := keycloakClient.post(ctx, fmt.Sprintf("/realms/%s/components", realmKey.RealmId), convertFromRealmKeystoreEddsaGeneratedToComponent(realmKey))
+	if err != nil {
+		return err
+	}
+
+	realmKey.Id = getIdFromLocationHeader(location)
+
+	return nil
+}
+
+func (keycloakClient *KeycloakClient) GetRealmKeystoreEddsaGenerated(ctx context.Context, realmId, id string) (*RealmKeystoreEddsaGenerated, error) {
+	var component *component
+
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/components/%s", realmId, id), &component, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return convertFromComponentToRealmKeystoreEddsaGenerated(component, realmId)
+}
+
+func (keycloakClient *KeycloakClient) UpdateRealmKeystoreEddsaGenerated(ctx context.Context, realmKey *RealmKeystoreEddsaGenerated) error {
+	return keycloakClient.put(ctx, fmt.Sprintf("/realms/%s/components/%s", realmKey.RealmId, realmKey.Id), convertFromRealmKeystoreEddsaGeneratedToComponent(realmKey))
+}
+
+func (keycloakClient *KeycloakClient) DeleteRealmKeystoreEddsaGenerated(ctx context.Context, realmId, id string) error {
+	return keycloakClient.delete(ctx, fmt.Sprintf("/realms/%s/components/%s", realmId, id), nil)
+}
@@ -0,0 +1,118 @@
+package keycloak
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+)
+
+type RealmKeystoreRsaEnc struct {
+	Id      string
+	Name    string
+	RealmId string
+
+	Active    bool
+	Enabled   bool
+	Priority  int
+	Algorithm string
+
+	PrivateKey  string
+	Certificate string
+}
+
+func convertFromRealmKeystoreRsaEncToComponent(realmKey *RealmKeystoreRsaEnc) *component {
+	componentConfig := map[string][]string{
+		"active": {
+			strconv.FormatBool(realmKey.Active),
+		},
+		"enabled": {
+			strconv.FormatBool(realmKey.Enabled),
+		},
+		"priority": {
+			strconv.Itoa(realmKey.Priority),
+		},
+		"algorithm": {
+			realmKey.Algorithm,
+		},
+		"privateKey": {
+			realmKey.PrivateKey,
+		},
+		"certificate": {
+			realmKey.Certificate,
+		},
+	}
+
+	return &component{
+		Id:           realmKey.Id,
+		Name:         realmKey.Name,
+		ParentId:     realmKey.RealmId,
+		ProviderId:   "rsa-enc",
+		ProviderType: "org.keycloak.keys.KeyProvider",
+		Config:       componentConfig,
+	}
+}
+
+func convertFromComponentToRealmKeystoreRsaEnc(component *component, realmId string) (*RealmKeystoreRsaEnc, error) {
+	active, err := parseBoolAndTreatEmptyStringAsFalse(component.getConfig("active"))
+	if err != nil {
+		return nil, err
+	}
+
+	enabled, err := parseBoolAndTreatEmptyStringAsFalse(component.getConfig("enabled"))
+	if err != nil {
+		return nil, err
+	}
+
+	priority := 0 // Default priority
+	if component.getConfig("priority") != "" {
+		priority, err = strconv.Atoi(component.getConfig("priority"))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	realmKey := &RealmKeystoreRsaEnc{
+		Id:      component.Id,
+		Name:    component.Name,
+		RealmId: realmId,
+
+		Active:      active,
+		Enabled:     enabled,
+		Priority:    priority,
+		Algorithm:   component.getConfig("algorithm"),
+		PrivateKey:  component.getConfig("privateKey"),
+		Certificate: component.getConfig("certificate"),
+	}
+
+	return realmKey, nil
+}
+
+func (keycloakClient *KeycloakClient) NewRealmKeystoreRsaEnc(ctx context.Context, realmKey *RealmKeystoreRsaEnc) error {
+	_, location, err := keycloakClient.post(ctx, fmt.Sprintf("/realms/%s/components", realmKey.RealmId), convertFromRealmKeystoreRsaEncToComponent(realmKey))
+	if err != nil {
+		return err
+	}
+
+	realmKey.Id = getIdFromLocationHeader(location)
+
+	return nil
+}
+
+func (keycloakClient *KeycloakClient) GetRealmKeystoreRsaEnc(ctx context.Context, realmId, id string) (*RealmKeystoreRsaEnc, error) {
+	var component *component
+
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/components/%s", realmId, id), &component, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return convertFromComponentToRealmKeystoreRsaEnc(component, realmId)
+}
+
+func (keycloakClient *KeycloakClient) UpdateRealmKeystoreRsaEnc(ctx context.Context, realmKey *RealmKeystoreRsaEnc) error {
+	return keycloakClient.put(ctx, fmt.Sprintf("/realms/%s/components/%s", realmKey.RealmId, realmKey.Id), convertFromRealmKeystoreRsaEncToComponent(realmKey))
+}
+
+func (keycloakClient *KeycloakClient) DeleteRealmKeystoreRsaEnc(ctx context.Context, realmId, id string) error {
+	return keycloakClient.delete(ctx, fmt.Sprintf("/realms/%s/components/%s", realmId, id), nil)
+}
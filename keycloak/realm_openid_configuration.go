@@ -0,0 +1,101 @@
+package keycloak
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+// https://openid.net/specs/openid-connect-discovery-1_0.html#ProviderMetadata
+type RealmOpenidConfiguration struct {
+	Issuer                                     string   `json:"issuer"`
+	AuthorizationEndpoint                      string   `json:"authorization_endpoint"`
+	TokenEndpoint                              string   `json:"token_endpoint"`
+	IntrospectionEndpoint                      string   `json:"introspection_endpoint"`
+	UserinfoEndpoint                           string   `json:"userinfo_endpoint"`
+	EndSessionEndpoint                         string   `json:"end_session_endpoint"`
+	JwksUri                                    string   `json:"jwks_uri"`
+	RegistrationEndpoint                       string   `json:"registration_endpoint"`
+	RevocationEndpoint                         string   `json:"revocation_endpoint"`
+	DeviceAuthorizationEndpoint                string   `json:"device_authorization_endpoint"`
+	BackchannelAuthenticationEndpoint          string   `json:"backchannel_authentication_endpoint"`
+	PushedAuthorizationRequestEndpoint         string   `json:"pushed_authorization_request_endpoint"`
+	CheckSessionIframe                         string   `json:"check_session_iframe"`
+	GrantTypesSupported                        []string `json:"grant_types_supported"`
+	ResponseTypesSupported                     []string `json:"response_types_supported"`
+	ResponseModesSupported                     []string `json:"response_modes_supported"`
+	SubjectTypesSupported                      []string `json:"subject_types_supported"`
+	ScopesSupported                            []string `json:"scopes_supported"`
+	ClaimsSupported                            []string `json:"claims_supported"`
+	IdTokenSigningAlgValuesSupported           []string `json:"id_token_signing_alg_values_supported"`
+	IdTokenEncryptionAlgValuesSupported        []string `json:"id_token_encryption_alg_values_supported"`
+	IdTokenEncryptionEncValuesSupported        []string `json:"id_token_encryption_enc_values_supported"`
+	TokenEndpointAuthMethodsSupported          []string `json:"token_endpoint_auth_methods_supported"`
+	TokenEndpointAuthSigningAlgValuesSupported []string `json:"token_endpoint_auth_signing_alg_values_supported"`
+	CodeChallengeMethodsSupported              []string `json:"code_challenge_methods_supported"`
+
+	// the unparsed document, for metadata that isn't covered by the fields above
+	Raw string `json:"-"`
+}
+
+// https://datatracker.ietf.org/doc/html/rfc7517#section-4
+type JsonWebKey struct {
+	Kid     string   `json:"kid"`
+	Kty     string   `json:"kty"`
+	Alg     string   `json:"alg"`
+	Use     string   `json:"use"`
+	N       string   `json:"n,omitempty"`
+	E       string   `json:"e,omitempty"`
+	Crv     string   `json:"crv,omitempty"`
+	X       string   `json:"x,omitempty"`
+	Y       string   `json:"y,omitempty"`
+	X5c     []string `json:"x5c,omitempty"`
+	X5t     string   `json:"x5t,omitempty"`
+	X5tS256 string   `json:"x5t#S256,omitempty"`
+}
+
+type JsonWebKeySet struct {
+	Keys []*JsonWebKey `json:"keys"`
+
+	Raw string `json:"-"`
+}
+
+// GetRealmOpenidConfiguration fetches the public discovery document of a realm
+func (keycloakClient *KeycloakClient) GetRealmOpenidConfiguration(ctx context.Context, realmId string) (*RealmOpenidConfiguration, error) {
+	body, err := keycloakClient.getPublicRaw(ctx, fmt.Sprintf("/realms/%s/.well-known/openid-configuration", url.PathEscape(realmId)))
+	if err != nil {
+		return nil, err
+	}
+
+	var openidConfiguration RealmOpenidConfiguration
+
+	err = json.Unmarshal(body, &openidConfiguration)
+	if err != nil {
+		return nil, err
+	}
+
+	openidConfiguration.Raw = string(body)
+
+	return &openidConfiguration, nil
+}
+
+// GetRealmJwks fetches the public keys of a realm from its certs endpoint. unlike GetRealmKeys, this only returns the keys
+// that are published to relying parties.
+func (keycloakClient *KeycloakClient) GetRealmJwks(ctx context.Context, realmId string) (*JsonWebKeySet, error) {
+	body, err := keycloakClient.getPublicRaw(ctx, fmt.Sprintf("/realms/%s/protocol/openid-connect/certs", url.PathEscape(realmId)))
+	if err != nil {
+		return nil, err
+	}
+
+	var jwks JsonWebKeySet
+
+	err = json.Unmarshal(body, &jwks)
+	if err != nil {
+		return nil, err
+	}
+
+	jwks.Raw = string(body)
+
+	return &jwks, nil
+}
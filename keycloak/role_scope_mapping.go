@@ -77,3 +77,56 @@ func (keycloakClient *KeycloakClient) DeleteRoleScopeMapping(ctx context.Context
 		return keycloakClient.delete(ctx, roleUrl, body)
 	}
 }
+
+func roleScopeMappingsUrl(realmId, clientId, clientScopeId string) string {
+	if clientId != "" {
+		return fmt.Sprintf("/realms/%s/clients/%s/scope-mappings", realmId, clientId)
+	}
+
+	return fmt.Sprintf("/realms/%s/client-scopes/%s/scope-mappings", realmId, clientScopeId)
+}
+
+// GetRoleScopeMappings returns all realm and client roles that are mapped into the scope of a client or client scope
+func (keycloakClient *KeycloakClient) GetRoleScopeMappings(ctx context.Context, realmId, clientId, clientScopeId string) (*RoleMapping, error) {
+	var roleMapping *RoleMapping
+
+	err := keycloakClient.get(ctx, roleScopeMappingsUrl(realmId, clientId, clientScopeId), &roleMapping, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return roleMapping, nil
+}
+
+func (keycloakClient *KeycloakClient) AddRealmRoleScopeMappings(ctx context.Context, realmId, clientId, clientScopeId string, roles []*Role) error {
+	_, _, err := keycloakClient.post(ctx, roleScopeMappingsUrl(realmId, clientId, clientScopeId)+"/realm", roles)
+
+	return err
+}
+
+// AddClientRoleScopeMappings maps roles of the client roleClientId into the scope of a client or client scope
+func (keycloakClient *KeycloakClient) AddClientRoleScopeMappings(ctx context.Context, realmId, clientId, clientScopeId, roleClientId string, roles []*Role) error {
+	_, _, err := keycloakClient.post(ctx, fmt.Sprintf("%s/clients/%s", roleScopeMappingsUrl(realmId, clientId, clientScopeId), roleClientId), roles)
+
+	return err
+}
+
+func (keycloakClient *KeycloakClient) RemoveRealmRoleScopeMappings(ctx context.Context, realmId, clientId, clientScopeId string, roles []*Role) error {
+	body := make([]RealmRoleRepresentation, 0, len(roles))
+	for _, role := range roles {
+		body = append(body, RealmRoleRepresentation{
+			Id:          role.Id,
+			Name:        role.Name,
+			Description: role.Description,
+			Composite:   role.Composite,
+			ClientRole:  role.ClientRole,
+			ContainerId: role.ContainerId,
+		})
+	}
+
+	return keycloakClient.delete(ctx, roleScopeMappingsUrl(realmId, clientId, clientScopeId)+"/realm", body)
+}
+
+func (keycloakClient *KeycloakClient) RemoveClientRoleScopeMappings(ctx context.Context, realmId, clientId, clientScopeId, roleClientId string, roles []*Role) error {
+	return keycloakClient.delete(ctx, fmt.Sprintf("%s/clients/%s", roleScopeMappingsUrl(realmId, clientId, clientScopeId), roleClientId), roles)
+}
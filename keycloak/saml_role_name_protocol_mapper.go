@@ -0,0 +1,127 @@
+package keycloak
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+type SamlRoleNameProtocolMapper struct {
+	Id            string
+	Name          string
+	RealmId       string
+	ClientId      string
+	ClientScopeId string
+
+	RoleId      string
+	NewRoleName string
+}
+
+func (mapper *SamlRoleNameProtocolMapper) convertToGenericProtocolMapper(roleProp string) *protocolMapper {
+	return &protocolMapper{
+		Id:             mapper.Id,
+		Name:           mapper.Name,
+		Protocol:       "saml",
+		ProtocolMapper: "saml-role-name-mapper",
+		Config: map[string]string{
+			roleField:        roleProp,
+			newRoleNameField: mapper.NewRoleName,
+		},
+	}
+}
+
+func (protocolMapper *protocolMapper) convertToSamlRoleNameProtocolMapper(realmId, clientId, clientScopeId, roleId string) *SamlRoleNameProtocolMapper {
+	return &SamlRoleNameProtocolMapper{
+		Id:            protocolMapper.Id,
+		Name:          protocolMapper.Name,
+		RealmId:       realmId,
+		ClientId:      clientId,
+		ClientScopeId: clientScopeId,
+
+		RoleId:      roleId,
+		NewRoleName: protocolMapper.Config[newRoleNameField],
+	}
+}
+
+func (keycloakClient *KeycloakClient) GetSamlRoleNameProtocolMapper(ctx context.Context, realmId, clientId, clientScopeId, mapperId string) (*SamlRoleNameProtocolMapper, error) {
+	var protocolMapper *protocolMapper
+
+	err := keycloakClient.get(ctx, individualProtocolMapperPath(realmId, clientId, clientScopeId, mapperId), &protocolMapper, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	role, err := keycloakClient.getRoleFromRoleProp(ctx, realmId, protocolMapper.Config[roleField])
+	if err != nil {
+		return nil, err
+	}
+
+	return protocolMapper.convertToSamlRoleNameProtocolMapper(realmId, clientId, clientScopeId, role.Id), nil
+}
+
+func (keycloakClient *KeycloakClient) DeleteSamlRoleNameProtocolMapper(ctx context.Context, realmId, clientId, clientScopeId, mapperId string) error {
+	return keycloakClient.delete(ctx, individualProtocolMapperPath(realmId, clientId, clientScopeId, mapperId), nil)
+}
+
+func (keycloakClient *KeycloakClient) NewSamlRoleNameProtocolMapper(ctx context.Context, mapper *SamlRoleNameProtocolMapper) error {
+	role, err := keycloakClient.GetRole(ctx, mapper.RealmId, mapper.RoleId)
+	if err != nil {
+		return err
+	}
+
+	roleProp, err := keycloakClient.getRolePropFromRole(ctx, role)
+	if err != nil {
+		return err
+	}
+
+	path := protocolMapperPath(mapper.RealmId, mapper.ClientId, mapper.ClientScopeId)
+
+	_, location, err := keycloakClient.post(ctx, path, mapper.convertToGenericProtocolMapper(roleProp))
+	if err != nil {
+		return err
+	}
+
+	mapper.Id = getIdFromLocationHeader(location)
+
+	return nil
+}
+
+func (keycloakClient *KeycloakClient) UpdateSamlRoleNameProtocolMapper(ctx context.Context, mapper *SamlRoleNameProtocolMapper) error {
+	role, err := keycloakClient.GetRole(ctx, mapper.RealmId, mapper.RoleId)
+	if err != nil {
+		return err
+	}
+
+	roleProp, err := keycloakClient.getRolePropFromRole(ctx, role)
+	if err != nil {
+		return err
+	}
+
+	path := individualProtocolMapperPath(mapper.RealmId, mapper.ClientId, mapper.ClientScopeId, mapper.Id)
+
+	return keycloakClient.put(ctx, path, mapper.convertToGenericProtocolMapper(roleProp))
+}
+
+func (keycloakClient *KeycloakClient) ValidateSamlRoleNameProtocolMapper(ctx context.Context, mapper *SamlRoleNameProtocolMapper) error {
+	if mapper.ClientId == "" && mapper.ClientScopeId == "" {
+		return fmt.Errorf("validation error: one of ClientId or ClientScopeId must be set")
+	}
+
+	// keycloak splits the new name on the first dot to find the client to move the role to
+	if strings.HasPrefix(mapper.NewRoleName, ".") || strings.HasSuffix(mapper.NewRoleName, ".") {
+		return fmt.Errorf("validation error: new role name %s must be a role name, or a client id and role name separated by a dot", mapper.NewRoleName)
+	}
+
+	protocolMappers, err := keycloakClient.listGenericProtocolMappers(ctx, mapper.RealmId, mapper.ClientId, mapper.ClientScopeId)
+	if err != nil {
+		return err
+	}
+
+	for _, protocolMapper := range protocolMappers {
+		if protocolMapper.Name == mapper.Name && protocolMapper.Id != mapper.Id {
+			return fmt.Errorf("validation error: a protocol mapper with name %s already exists for this client", mapper.Name)
+		}
+	}
+
+	return nil
+}
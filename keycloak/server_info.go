@@ -1,6 +1,10 @@
 package keycloak
 
-import "context"
+import (
+	"context"
+	"fmt"
+	"sort"
+)
 
 type SystemInfo struct {
 	ServerVersion string `json:"version"`
@@ -23,11 +27,23 @@ type Theme struct {
 	Locales []string `json:"locales,omitempty"`
 }
 
+type ProfileInfo struct {
+	DisabledFeatures []string `json:"disabledFeatures"`
+}
+
+type Feature struct {
+	Name    string `json:"name"`
+	Enabled bool   `json:"enabled"`
+}
+
 type ServerInfo struct {
-	SystemInfo     SystemInfo                 `json:"systemInfo"`
-	ComponentTypes map[string][]ComponentType `json:"componentTypes"`
-	ProviderTypes  map[string]ProviderType    `json:"providers"`
-	Themes         map[string][]Theme         `json:"themes"`
+	SystemInfo          SystemInfo                       `json:"systemInfo"`
+	ProfileInfo         ProfileInfo                      `json:"profileInfo"`
+	Features            []Feature                        `json:"features"`
+	ComponentTypes      map[string][]ComponentType       `json:"componentTypes"`
+	ProtocolMapperTypes map[string][]*ProtocolMapperType `json:"protocolMapperTypes"`
+	ProviderTypes       map[string]ProviderType          `json:"providers"`
+	Themes              map[string][]Theme               `json:"themes"`
 }
 
 func (serverInfo *ServerInfo) ThemeIsInstalled(t, themeName string) bool {
@@ -54,6 +70,28 @@ func (serverInfo *ServerInfo) ComponentTypeIsInstalled(componentType, componentT
 	return false
 }
 
+// FeatureIsEnabled checks whether a feature, such as DYNAMIC_SCOPES, is enabled on the server. keycloak 22 and later list
+// every feature along with its state, older versions only list the features that are disabled.
+func (serverInfo *ServerInfo) FeatureIsEnabled(featureName string) bool {
+	if len(serverInfo.Features) != 0 {
+		for _, feature := range serverInfo.Features {
+			if feature.Name == featureName {
+				return feature.Enabled
+			}
+		}
+
+		return false
+	}
+
+	for _, disabledFeature := range serverInfo.ProfileInfo.DisabledFeatures {
+		if disabledFeature == featureName {
+			return false
+		}
+	}
+
+	return true
+}
+
 func (serverInfo *ServerInfo) getInstalledProvidersNames(providerType string) []string {
 	providers := serverInfo.ProviderTypes[providerType].Providers
 	keys := make([]string, 0, len(providers))
@@ -73,7 +111,33 @@ func (serverInfo *ServerInfo) providerInstalled(providerType, providerName strin
 	return false
 }
 
+// ValidateAlgorithm returns an error if the server doesn't have a provider of providerType for algorithm, such as a
+// signature or cekmanagement provider. servers that don't list any providers of this type aren't validated.
+func (serverInfo *ServerInfo) ValidateAlgorithm(description, providerType, algorithm string) error {
+	installed := serverInfo.getInstalledProvidersNames(providerType)
+	if algorithm == "" || len(installed) == 0 || serverInfo.providerInstalled(providerType, algorithm) {
+		return nil
+	}
+
+	sort.Strings(installed)
+
+	return fmt.Errorf("validation error: %s algorithm \"%s\" is not supported by the server, supported algorithms: %s", description, algorithm, installed)
+}
+
+// GetServerInfo returns the server info that was fetched when logging in. it only changes when keycloak is redeployed,
+// so it is fetched once per provider instance, the same way as the server version
 func (keycloakClient *KeycloakClient) GetServerInfo(ctx context.Context) (*ServerInfo, error) {
+	if keycloakClient.serverInfo == nil {
+		err := keycloakClient.login(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return keycloakClient.serverInfo, nil
+}
+
+func (keycloakClient *KeycloakClient) getServerInfo(ctx context.Context) (*ServerInfo, error) {
 	var serverInfo ServerInfo
 
 	err := keycloakClient.get(ctx, "/serverinfo", &serverInfo, nil)
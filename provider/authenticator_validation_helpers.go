@@ -0,0 +1,156 @@
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/charlesderek/terraform-w-keycloak/keycloak"
+)
+
+// validateAuthenticatorCustomizeDiff validates the `authenticator` attribute against the authenticators that are installed
+// on the server. unknown or empty values are skipped, since subflows usually don't have an authenticator.
+func validateAuthenticatorCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	rawConfig := d.GetRawConfig()
+	if rawConfig.IsNull() {
+		return nil
+	}
+
+	authenticator := rawConfig.GetAttr("authenticator")
+	if !authenticator.IsKnown() || authenticator.IsNull() || authenticator.AsString() == "" {
+		return nil
+	}
+
+	return keycloakClient.ValidateAuthenticator(ctx, authenticator.AsString())
+}
+
+// validateAuthenticationExecutionConfigCustomizeDiff validates the `config` of an execution config against the config
+// description of the execution's authenticator. this is only possible once the execution exists.
+func validateAuthenticationExecutionConfigCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	rawConfig := d.GetRawConfig()
+	if rawConfig.IsNull() {
+		return nil
+	}
+
+	if !rawConfig.GetAttr("realm_id").IsKnown() || !rawConfig.GetAttr("execution_id").IsKnown() || !rawConfig.GetAttr("config").IsWhollyKnown() {
+		return nil
+	}
+
+	execution, err := keycloakClient.GetAuthenticationExecution(ctx, d.Get("realm_id").(string), "", d.Get("execution_id").(string))
+	if err != nil {
+		if keycloak.ErrorIs404(err) {
+			return nil
+		}
+
+		return err
+	}
+
+	config := make(map[string]string)
+	for key, value := range d.Get("config").(map[string]interface{}) {
+		config[key] = value.(string)
+	}
+
+	return keycloakClient.ValidateAuthenticatorConfig(ctx, execution.Authenticator, config)
+}
+
+// validateAuthenticationFlowTreeCustomizeDiff walks the executions of a flow tree, validating every authenticator and
+// authenticator config whose values are already known
+func validateAuthenticationFlowTreeCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	rawConfig := d.GetRawConfig()
+	if rawConfig.IsNull() {
+		return nil
+	}
+
+	return validateAuthenticationFlowTreeExecutions(ctx, keycloakClient, rawConfig.GetAttr("execution"))
+}
+
+func validateAuthenticationFlowTreeExecutions(ctx context.Context, keycloakClient *keycloak.KeycloakClient, executions cty.Value) error {
+	if !executions.IsKnown() || executions.IsNull() {
+		return nil
+	}
+
+	for it := executions.ElementIterator(); it.Next(); {
+		_, execution := it.Element()
+		if !execution.IsKnown() || execution.IsNull() {
+			continue
+		}
+
+		authenticator := execution.GetAttr("authenticator")
+		authenticatorKnown := authenticator.IsKnown() && !authenticator.IsNull() && authenticator.AsString() != ""
+
+		if authenticatorKnown {
+			err := keycloakClient.ValidateAuthenticator(ctx, authenticator.AsString())
+			if err != nil {
+				return err
+			}
+
+			err = validateAuthenticationFlowTreeAuthenticatorConfig(ctx, keycloakClient, authenticator.AsString(), execution.GetAttr("authenticator_config"))
+			if err != nil {
+				return err
+			}
+		}
+
+		if !execution.Type().HasAttribute("subflow") {
+			continue
+		}
+
+		subFlows := execution.GetAttr("subflow")
+		if !subFlows.IsKnown() || subFlows.IsNull() {
+			continue
+		}
+
+		for subFlowIt := subFlows.ElementIterator(); subFlowIt.Next(); {
+			_, subFlow := subFlowIt.Element()
+			if !subFlow.IsKnown() || subFlow.IsNull() {
+				continue
+			}
+
+			err := validateAuthenticationFlowTreeExecutions(ctx, keycloakClient, subFlow.GetAttr("execution"))
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func validateAuthenticationFlowTreeAuthenticatorConfig(ctx context.Context, keycloakClient *keycloak.KeycloakClient, authenticator string, authenticatorConfigs cty.Value) error {
+	if !authenticatorConfigs.IsKnown() || authenticatorConfigs.IsNull() {
+		return nil
+	}
+
+	for it := authenticatorConfigs.ElementIterator(); it.Next(); {
+		_, authenticatorConfig := it.Element()
+		if !authenticatorConfig.IsWhollyKnown() || authenticatorConfig.IsNull() {
+			continue
+		}
+
+		configValue := authenticatorConfig.GetAttr("config")
+		if configValue.IsNull() {
+			continue
+		}
+
+		config := make(map[string]string)
+		for configIt := configValue.ElementIterator(); configIt.Next(); {
+			key, value := configIt.Element()
+			if value.IsNull() {
+				continue
+			}
+
+			config[key.AsString()] = value.AsString()
+		}
+
+		err := keycloakClient.ValidateAuthenticatorConfig(ctx, authenticator, config)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
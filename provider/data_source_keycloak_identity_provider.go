@@ -0,0 +1,193 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/charlesderek/terraform-w-keycloak/keycloak"
+)
+
+// identity provider config keys that should never be exposed by the identity provider data sources
+var identityProviderSecretConfigKeys = []string{
+	"clientSecret",
+}
+
+func dataSourceKeycloakIdentityProvider() *schema.Resource {
+	dataSourceSchema := identityProviderDataSourceSchema()
+	dataSourceSchema["realm"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+	}
+	dataSourceSchema["alias"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceKeycloakIdentityProviderRead,
+		Schema:      dataSourceSchema,
+	}
+}
+
+// identityProviderDataSourceSchema contains the computed attributes shared by the identity provider data sources
+func identityProviderDataSourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"alias": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"internal_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"provider_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"display_name": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"enabled": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"store_token": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"add_read_token_role_on_create": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"authenticate_by_default": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"link_only": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"trust_email": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"hide_on_login_page": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"first_broker_login_flow_alias": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"post_broker_login_flow_alias": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"config": {
+			Type:     schema.TypeMap,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+	}
+}
+
+// getNonSecretIdentityProviderConfig converts the identity provider config to the same key/value pairs that Keycloak
+// uses, excluding any secrets and empty values
+func getNonSecretIdentityProviderConfig(config *keycloak.IdentityProviderConfig) (map[string]string, error) {
+	result := make(map[string]string)
+	if config == nil {
+		return result, nil
+	}
+
+	configJson, err := json.Marshal(config)
+	if err != nil {
+		return nil, err
+	}
+
+	var configMap map[string]interface{}
+	if err = json.Unmarshal(configJson, &configMap); err != nil {
+		return nil, err
+	}
+
+	for k, v := range configMap {
+		if stringSliceContains(identityProviderSecretConfigKeys, k) {
+			continue
+		}
+
+		value, ok := v.(string)
+		if !ok {
+			valueJson, err := json.Marshal(v)
+			if err != nil {
+				return nil, err
+			}
+			value = string(valueJson)
+		}
+
+		if value == "" {
+			continue
+		}
+
+		result[k] = value
+	}
+
+	return result, nil
+}
+
+func flattenIdentityProvider(identityProvider *keycloak.IdentityProvider) (map[string]interface{}, error) {
+	config, err := getNonSecretIdentityProviderConfig(identityProvider.Config)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read config for identity provider %s: %s", identityProvider.Alias, err)
+	}
+
+	var hideOnLoginPage bool
+	if identityProvider.Config != nil {
+		hideOnLoginPage = bool(identityProvider.Config.HideOnLoginPage)
+	}
+
+	return map[string]interface{}{
+		"alias":                         identityProvider.Alias,
+		"internal_id":                   identityProvider.InternalId,
+		"provider_id":                   identityProvider.ProviderId,
+		"display_name":                  identityProvider.DisplayName,
+		"enabled":                       identityProvider.Enabled,
+		"store_token":                   identityProvider.StoreToken,
+		"add_read_token_role_on_create": identityProvider.AddReadTokenRoleOnCreate,
+		"authenticate_by_default":       identityProvider.AuthenticateByDefault,
+		"link_only":                     identityProvider.LinkOnly,
+		"trust_email":                   identityProvider.TrustEmail,
+		"hide_on_login_page":            hideOnLoginPage,
+		"first_broker_login_flow_alias": identityProvider.FirstBrokerLoginFlowAlias,
+		"post_broker_login_flow_alias":  identityProvider.PostBrokerLoginFlowAlias,
+		"config":                        config,
+	}, nil
+}
+
+func dataSourceKeycloakIdentityProviderRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realm := data.Get("realm").(string)
+	alias := data.Get("alias").(string)
+
+	identityProvider, err := keycloakClient.GetIdentityProvider(ctx, realm, alias)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	identityProviderData, err := flattenIdentityProvider(identityProvider)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(fmt.Sprintf("%s/%s", realm, identityProvider.Alias))
+
+	for k, v := range identityProviderData {
+		if err = data.Set(k, v); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,247 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/base64"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/charlesderek/terraform-w-keycloak/keycloak"
+)
+
+func dataSourceKeycloakIdentityProviderImportConfig() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceKeycloakIdentityProviderImportConfigRead,
+		Schema: map[string]*schema.Schema{
+			"realm": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"provider_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "oidc",
+				ValidateFunc: validation.StringInSlice([]string{"oidc", "keycloak-oidc", "saml"}, false),
+				Description:  "The type of identity provider the configuration is imported for, either oidc, keycloak-oidc or saml.",
+			},
+			"discovery_url": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"discovery_url", "metadata"},
+				Description:  "The url of an OIDC discovery document or SAML metadata descriptor, which will be fetched by Keycloak.",
+			},
+			"metadata": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"discovery_url", "metadata"},
+				Description:  "The contents of an OIDC discovery document or SAML metadata descriptor (XML).",
+			},
+			"alias": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The alias of an existing identity provider in the realm to compare with the imported configuration.",
+			},
+			"drifted": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the identity provider given by alias differs from the imported configuration.",
+			},
+			"drifted_fields": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The attributes of this data source whose imported value differs from the identity provider given by alias.",
+			},
+			// oidc
+			"authorization_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"token_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"user_info_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"jwks_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"logout_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"issuer": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			// saml
+			"idp_entity_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"single_sign_on_service_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"single_logout_service_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"signing_certificate": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name_id_policy_format": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"post_binding_authn_request": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"post_binding_response": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"post_binding_logout": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"want_authn_requests_signed": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"want_assertions_signed": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			// shared
+			"validate_signature": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"config": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Any other configuration values returned by Keycloak that do not have a dedicated attribute.",
+			},
+		},
+	}
+}
+
+// the imported values that are compared with an existing identity provider. settings that are chosen locally rather than
+// dictated by the upstream metadata, such as validate_signature, aren't compared.
+var identityProviderImportConfigDriftFields = []struct {
+	attribute string
+	value     func(config *keycloak.IdentityProviderConfig) string
+}{
+	{"authorization_url", func(config *keycloak.IdentityProviderConfig) string { return config.AuthorizationUrl }},
+	{"token_url", func(config *keycloak.IdentityProviderConfig) string { return config.TokenUrl }},
+	{"user_info_url", func(config *keycloak.IdentityProviderConfig) string { return config.UserInfoUrl }},
+	{"jwks_url", func(config *keycloak.IdentityProviderConfig) string { return config.JwksUrl }},
+	{"logout_url", func(config *keycloak.IdentityProviderConfig) string { return config.LogoutUrl }},
+	{"issuer", func(config *keycloak.IdentityProviderConfig) string { return config.Issuer }},
+	{"single_sign_on_service_url", func(config *keycloak.IdentityProviderConfig) string { return config.SingleSignOnServiceUrl }},
+	{"single_logout_service_url", func(config *keycloak.IdentityProviderConfig) string { return config.SingleLogoutServiceUrl }},
+	{"signing_certificate", func(config *keycloak.IdentityProviderConfig) string { return config.SigningCertificate }},
+	{"name_id_policy_format", func(config *keycloak.IdentityProviderConfig) string { return config.NameIDPolicyFormat }},
+}
+
+// getIdentityProviderImportConfigDrift returns the attributes whose imported value differs from the identity provider.
+// values that are missing from the imported document are ignored, since they can't tell anything about drift.
+func getIdentityProviderImportConfigDrift(imported, existing *keycloak.IdentityProviderConfig) []string {
+	driftedFields := make([]string, 0)
+	for _, field := range identityProviderImportConfigDriftFields {
+		value := field.value(imported)
+		if value != "" && value != field.value(existing) {
+			driftedFields = append(driftedFields, field.attribute)
+		}
+	}
+
+	return driftedFields
+}
+
+func dataSourceKeycloakIdentityProviderImportConfigRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realm := data.Get("realm").(string)
+	providerId := data.Get("provider_id").(string)
+
+	var config *keycloak.IdentityProviderConfig
+	var err error
+	var source string
+
+	if discoveryUrl, ok := data.GetOk("discovery_url"); ok {
+		source = discoveryUrl.(string)
+		config, err = keycloakClient.ImportIdentityProviderConfig(ctx, realm, providerId, source)
+	} else {
+		source = data.Get("metadata").(string)
+		config, err = keycloakClient.ImportIdentityProviderConfigFromMetadata(ctx, realm, providerId, source)
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	h := sha1.New()
+	h.Write([]byte(realm + "/" + providerId + "/" + source))
+	data.SetId(base64.URLEncoding.EncodeToString(h.Sum(nil)))
+
+	data.Set("authorization_url", config.AuthorizationUrl)
+	data.Set("token_url", config.TokenUrl)
+	data.Set("user_info_url", config.UserInfoUrl)
+	data.Set("jwks_url", config.JwksUrl)
+	data.Set("logout_url", config.LogoutUrl)
+	data.Set("issuer", config.Issuer)
+
+	var nameIdPolicyFormat string
+	if format, ok := mapKeyFromValue(nameIdPolicyFormats, config.NameIDPolicyFormat); ok {
+		nameIdPolicyFormat = format
+	}
+
+	data.Set("single_sign_on_service_url", config.SingleSignOnServiceUrl)
+	data.Set("single_logout_service_url", config.SingleLogoutServiceUrl)
+	data.Set("signing_certificate", config.SigningCertificate)
+	data.Set("name_id_policy_format", nameIdPolicyFormat)
+	data.Set("post_binding_authn_request", config.PostBindingAuthnRequest)
+	data.Set("post_binding_response", config.PostBindingResponse)
+	data.Set("post_binding_logout", config.PostBindingLogout)
+	data.Set("want_authn_requests_signed", config.WantAuthnRequestsSigned)
+	data.Set("want_assertions_signed", config.WantAssertionsSigned)
+	data.Set("validate_signature", config.ValidateSignature)
+
+	extraConfig := make(map[string]string, len(config.ExtraConfig))
+	for k, v := range config.ExtraConfig {
+		if k == "idpEntityId" {
+			data.Set("idp_entity_id", v)
+			continue
+		}
+
+		if s, ok := v.(string); ok {
+			extraConfig[k] = s
+		} else {
+			extraConfig[k] = fmt.Sprintf("%v", v)
+		}
+	}
+	data.Set("config", extraConfig)
+
+	driftedFields := make([]string, 0)
+	if alias, ok := data.GetOk("alias"); ok {
+		identityProvider, err := keycloakClient.GetIdentityProvider(ctx, realm, alias.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		driftedFields = getIdentityProviderImportConfigDrift(config, identityProvider.Config)
+	}
+
+	data.Set("drifted", len(driftedFields) != 0)
+	data.Set("drifted_fields", driftedFields)
+
+	return nil
+}
@@ -0,0 +1,158 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccKeycloakDataSourceIdentityProviderImportConfig_oidcDiscoveryUrl(t *testing.T) {
+	t.Parallel()
+
+	dataSourceName := "data.keycloak_identity_provider_import_config.oidc"
+	issuer := fmt.Sprintf("%s/realms/%s", os.Getenv("KEYCLOAK_URL"), testAccRealmTwo.Realm)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceKeycloakIdentityProviderImportConfig_oidcDiscoveryUrl(issuer),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "issuer", issuer),
+					resource.TestCheckResourceAttr(dataSourceName, "authorization_url", issuer+"/protocol/openid-connect/auth"),
+					resource.TestCheckResourceAttr(dataSourceName, "token_url", issuer+"/protocol/openid-connect/token"),
+					resource.TestCheckResourceAttr(dataSourceName, "jwks_url", issuer+"/protocol/openid-connect/certs"),
+					resource.TestCheckResourceAttr(dataSourceName, "validate_signature", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakDataSourceIdentityProviderImportConfig_samlMetadata(t *testing.T) {
+	t.Parallel()
+
+	dataSourceName := "data.keycloak_identity_provider_import_config.saml"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceKeycloakIdentityProviderImportConfig_samlMetadata(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "idp_entity_id", "https://idp.example.com/metadata"),
+					resource.TestCheckResourceAttr(dataSourceName, "single_sign_on_service_url", "https://idp.example.com/sso"),
+					resource.TestCheckResourceAttr(dataSourceName, "single_logout_service_url", "https://idp.example.com/slo"),
+					resource.TestCheckResourceAttr(dataSourceName, "name_id_policy_format", "Persistent"),
+					resource.TestCheckResourceAttr(dataSourceName, "post_binding_response", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakDataSourceIdentityProviderImportConfig_drift(t *testing.T) {
+	t.Parallel()
+
+	dataSourceName := "data.keycloak_identity_provider_import_config.saml"
+	alias := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakSamlIdentityProviderDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceKeycloakIdentityProviderImportConfig_drift(alias, "https://idp.example.com/sso"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "drifted", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "drifted_fields.#", "0"),
+				),
+			},
+			{
+				// the upstream metadata moved its single sign on service, while the identity provider still uses the old one
+				Config: testDataSourceKeycloakIdentityProviderImportConfig_drift(alias, "https://idp.example.com/sso/v2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "drifted", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "drifted_fields.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "drifted_fields.0", "single_sign_on_service_url"),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourceKeycloakIdentityProviderImportConfig_oidcDiscoveryUrl(issuer string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+data "keycloak_identity_provider_import_config" "oidc" {
+	realm         = data.keycloak_realm.realm.id
+	provider_id   = "oidc"
+	discovery_url = "%s/.well-known/openid-configuration"
+}
+	`, testAccRealm.Realm, issuer)
+}
+
+func testDataSourceKeycloakIdentityProviderImportConfig_samlMetadata() string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+data "keycloak_identity_provider_import_config" "saml" {
+	realm       = data.keycloak_realm.realm.id
+	provider_id = "saml"
+	metadata    = <<EOT
+<md:EntityDescriptor xmlns:md="urn:oasis:names:tc:SAML:2.0:metadata" entityID="https://idp.example.com/metadata">
+	<md:IDPSSODescriptor protocolSupportEnumeration="urn:oasis:names:tc:SAML:2.0:protocol">
+		<md:SingleLogoutService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST" Location="https://idp.example.com/slo"/>
+		<md:NameIDFormat>urn:oasis:names:tc:SAML:2.0:nameid-format:persistent</md:NameIDFormat>
+		<md:SingleSignOnService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST" Location="https://idp.example.com/sso"/>
+	</md:IDPSSODescriptor>
+</md:EntityDescriptor>
+EOT
+}
+	`, testAccRealm.Realm)
+}
+
+func testDataSourceKeycloakIdentityProviderImportConfig_drift(alias, singleSignOnServiceUrl string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_saml_identity_provider" "saml" {
+	realm                      = data.keycloak_realm.realm.id
+	alias                      = "%s"
+	entity_id                  = "https://example.com/entity_id"
+	single_sign_on_service_url = "https://idp.example.com/sso"
+	single_logout_service_url  = "https://idp.example.com/slo"
+	name_id_policy_format      = "Persistent"
+}
+
+data "keycloak_identity_provider_import_config" "saml" {
+	realm       = data.keycloak_realm.realm.id
+	provider_id = "saml"
+	alias       = keycloak_saml_identity_provider.saml.alias
+	metadata    = <<EOT
+<md:EntityDescriptor xmlns:md="urn:oasis:names:tc:SAML:2.0:metadata" entityID="https://idp.example.com/metadata">
+	<md:IDPSSODescriptor protocolSupportEnumeration="urn:oasis:names:tc:SAML:2.0:protocol">
+		<md:SingleLogoutService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST" Location="https://idp.example.com/slo"/>
+		<md:NameIDFormat>urn:oasis:names:tc:SAML:2.0:nameid-format:persistent</md:NameIDFormat>
+		<md:SingleSignOnService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST" Location="%s"/>
+	</md:IDPSSODescriptor>
+</md:EntityDescriptor>
+EOT
+
+	depends_on = [keycloak_saml_identity_provider.saml]
+}
+	`, testAccRealm.Realm, alias, singleSignOnServiceUrl)
+}
@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccKeycloakDataSourceIdentityProvider_basic(t *testing.T) {
+	t.Parallel()
+	alias := acctest.RandomWithPrefix("tf-acc")
+	dataSourceName := "data.keycloak_identity_provider.test"
+	resourceName := "keycloak_oidc_identity_provider.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeycloakDataSourceIdentityProvider_basic(alias),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "alias", resourceName, "alias"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "internal_id", resourceName, "internal_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "enabled", resourceName, "enabled"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "trust_email", resourceName, "trust_email"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "first_broker_login_flow_alias", resourceName, "first_broker_login_flow_alias"),
+					resource.TestCheckResourceAttr(dataSourceName, "provider_id", "oidc"),
+					resource.TestCheckResourceAttr(dataSourceName, "config.clientId", "example_id"),
+					resource.TestCheckNoResourceAttr(dataSourceName, "config.clientSecret"),
+				),
+			},
+		},
+	})
+}
+
+func testAccKeycloakDataSourceIdentityProvider_basic(alias string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_oidc_identity_provider" "test" {
+	realm             = data.keycloak_realm.realm.id
+	alias             = "%s"
+	authorization_url = "https://example.com/auth"
+	token_url         = "https://example.com/token"
+	client_id         = "example_id"
+	client_secret     = "example_token"
+	trust_email       = true
+}
+
+data "keycloak_identity_provider" "test" {
+	realm = data.keycloak_realm.realm.id
+	alias = keycloak_oidc_identity_provider.test.alias
+}
+`, testAccRealm.Realm, alias)
+}
@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/charlesderek/terraform-w-keycloak/keycloak"
+)
+
+func dataSourceKeycloakIdentityProviders() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceKeycloakIdentityProvidersRead,
+		Schema: map[string]*schema.Schema{
+			"realm": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"provider_ids": {
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				Description: "When set, only identity providers of these types (such as oidc, saml or github) are returned.",
+			},
+			"identity_providers": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: identityProviderDataSourceSchema(),
+				},
+			},
+		},
+	}
+}
+
+func dataSourceKeycloakIdentityProvidersRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realm := data.Get("realm").(string)
+
+	identityProviders, err := keycloakClient.GetIdentityProviders(ctx, realm)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var providerIds []interface{}
+	if v, ok := data.GetOk("provider_ids"); ok {
+		providerIds = v.(*schema.Set).List()
+	}
+
+	identityProvidersData := make([]interface{}, 0, len(identityProviders))
+	for _, identityProvider := range identityProviders {
+		if providerIds != nil && !Contains(providerIds, identityProvider.ProviderId) {
+			continue
+		}
+
+		identityProviderData, err := flattenIdentityProvider(identityProvider)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		identityProvidersData = append(identityProvidersData, identityProviderData)
+	}
+
+	data.SetId(realm)
+
+	return diag.FromErr(data.Set("identity_providers", identityProvidersData))
+}
@@ -0,0 +1,76 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccKeycloakDataSourceIdentityProviders_filterByProviderId(t *testing.T) {
+	t.Parallel()
+	realmName := acctest.RandomWithPrefix("tf-acc")
+	oidcAlias := acctest.RandomWithPrefix("tf-acc")
+	samlAlias := acctest.RandomWithPrefix("tf-acc")
+	dataSourceName := "data.keycloak_identity_providers.oidc"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeycloakDataSourceIdentityProviders_filterByProviderId(realmName, oidcAlias, samlAlias),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "identity_providers.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "identity_providers.0.alias", oidcAlias),
+					resource.TestCheckResourceAttr(dataSourceName, "identity_providers.0.provider_id", "oidc"),
+					resource.TestCheckResourceAttr("data.keycloak_identity_providers.all", "identity_providers.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccKeycloakDataSourceIdentityProviders_filterByProviderId(realm, oidcAlias, samlAlias string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_oidc_identity_provider" "oidc" {
+	realm             = keycloak_realm.realm.id
+	alias             = "%s"
+	authorization_url = "https://example.com/auth"
+	token_url         = "https://example.com/token"
+	client_id         = "example_id"
+	client_secret     = "example_token"
+}
+
+resource "keycloak_saml_identity_provider" "saml" {
+	realm                      = keycloak_realm.realm.id
+	alias                      = "%s"
+	entity_id                  = "https://example.com/entity_id"
+	single_sign_on_service_url = "https://example.com/auth"
+}
+
+data "keycloak_identity_providers" "oidc" {
+	realm        = keycloak_realm.realm.id
+	provider_ids = ["oidc"]
+
+	depends_on = [
+		keycloak_oidc_identity_provider.oidc,
+		keycloak_saml_identity_provider.saml,
+	]
+}
+
+data "keycloak_identity_providers" "all" {
+	realm = keycloak_realm.realm.id
+
+	depends_on = [
+		keycloak_oidc_identity_provider.oidc,
+		keycloak_saml_identity_provider.saml,
+	]
+}
+`, realm, oidcAlias, samlAlias)
+}
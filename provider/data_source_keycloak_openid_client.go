@@ -46,6 +46,104 @@ func dataSourceKeycloakOpenidClient() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"token_endpoint_auth_signing_alg": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"use_jwks_url": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"jwks_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"x509_subject_dn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"x509_allow_regex_pattern_comparison": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"tls_client_certificate_bound_access_tokens": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"require_pushed_authorization_requests": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"ciba_grant_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"ciba_backchannel_token_delivery_mode": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ciba_backchannel_client_notification_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"dpop_bound_access_tokens": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"id_token_signed_response_alg": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"id_token_encrypted_response_alg": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"id_token_encrypted_response_enc": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"user_info_response_signature_alg": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"user_info_encrypted_response_alg": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"user_info_encrypted_response_enc": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"authorization_signed_response_alg": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"authorization_encrypted_response_alg": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"authorization_encrypted_response_enc": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"standard_token_exchange_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"standard_token_exchange_refresh_token_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"acr_loa_map": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"default_acr_values": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"standard_flow_enabled": {
 				Type:     schema.TypeBool,
 				Computed: true,
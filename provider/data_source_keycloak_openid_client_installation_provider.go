@@ -0,0 +1,173 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/charlesderek/terraform-w-keycloak/keycloak"
+)
+
+// the adapter configuration rendered by the keycloak-oidc-keycloak-json provider
+type openidClientAdapterConfig struct {
+	Realm            string `json:"realm"`
+	AuthServerUrl    string `json:"auth-server-url"`
+	SslRequired      string `json:"ssl-required"`
+	Resource         string `json:"resource"`
+	PublicClient     bool   `json:"public-client"`
+	BearerOnly       bool   `json:"bearer-only"`
+	ConfidentialPort int    `json:"confidential-port"`
+	Credentials      struct {
+		Secret string `json:"secret"`
+	} `json:"credentials"`
+}
+
+func dataSourceKeycloakOpenidClientInstallationProvider() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceKeycloakOpenidClientInstallationProviderRead,
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"client_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"provider_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"value": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			// the attributes below are only set when the provider returns a json document
+			"attributes": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"realm": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"auth_server_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ssl_required": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"resource": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"public_client": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"bearer_only": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"confidential_port": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"client_secret": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+// flattenOpenidClientAdapterAttributes converts the top level keys of a json adapter configuration into a map of
+// strings. values that aren't strings are json encoded, and the credentials are left out since they are exposed through
+// the sensitive client_secret attribute instead.
+func flattenOpenidClientAdapterAttributes(document map[string]interface{}) (map[string]string, error) {
+	attributes := make(map[string]string, len(document))
+
+	for key, value := range document {
+		if key == "credentials" {
+			continue
+		}
+
+		if s, ok := value.(string); ok {
+			attributes[key] = s
+			continue
+		}
+
+		encoded, err := json.Marshal(value)
+		if err != nil {
+			return nil, fmt.Errorf("unable to encode adapter configuration attribute %s: %s", key, err)
+		}
+
+		attributes[key] = string(encoded)
+	}
+
+	return attributes, nil
+}
+
+func dataSourceKeycloakOpenidClientInstallationProviderRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	clientId := data.Get("client_id").(string)
+	providerId := data.Get("provider_id").(string)
+
+	value, err := keycloakClient.GetOpenidClientInstallationProvider(ctx, realmId, clientId, providerId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	h := sha1.New()
+	h.Write(value)
+	id := base64.URLEncoding.EncodeToString(h.Sum(nil))
+
+	data.SetId(id)
+	data.Set("realm_id", realmId)
+	data.Set("client_id", clientId)
+	data.Set("provider_id", providerId)
+	data.Set("value", string(value))
+
+	// providers such as keycloak-oidc-jboss-subsystem return xml, in which case only the raw value is available
+	var document map[string]interface{}
+	if err := json.Unmarshal(value, &document); err != nil {
+		data.Set("attributes", map[string]string{})
+
+		return nil
+	}
+
+	attributes, err := flattenOpenidClientAdapterAttributes(document)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var adapterConfig openidClientAdapterConfig
+	if err := json.Unmarshal(value, &adapterConfig); err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.Set("attributes", attributes)
+	data.Set("realm", adapterConfig.Realm)
+	data.Set("auth_server_url", adapterConfig.AuthServerUrl)
+	data.Set("ssl_required", adapterConfig.SslRequired)
+	data.Set("resource", adapterConfig.Resource)
+	data.Set("public_client", adapterConfig.PublicClient)
+	data.Set("bearer_only", adapterConfig.BearerOnly)
+	data.Set("confidential_port", adapterConfig.ConfidentialPort)
+	data.Set("client_secret", adapterConfig.Credentials.Secret)
+
+	return nil
+}
@@ -0,0 +1,110 @@
+package provider
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccKeycloakDataSourceOpenidClientInstallationProvider_keycloakJson(t *testing.T) {
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
+
+	resourceName := "keycloak_openid_client.client"
+	dataSourceName := "data.keycloak_openid_client_installation_provider.keycloak_json"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakOpenidClientDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceKeycloakOpenidClientInstallationProvider(clientId, "keycloak-oidc-keycloak-json"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "realm_id", resourceName, "realm_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "client_id", resourceName, "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "provider_id", "keycloak-oidc-keycloak-json"),
+					resource.TestCheckResourceAttr(dataSourceName, "realm", testAccRealm.Realm),
+					resource.TestCheckResourceAttr(dataSourceName, "resource", clientId),
+					resource.TestCheckResourceAttr(dataSourceName, "public_client", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "attributes.resource", clientId),
+					resource.TestCheckResourceAttrPair(dataSourceName, "client_secret", resourceName, "client_secret"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "auth_server_url"),
+					resource.TestCheckNoResourceAttr(dataSourceName, "attributes.credentials"),
+					testAccCheckDataKeycloakOpenidClientInstallationProvider(dataSourceName, func(value []byte) error {
+						return json.Unmarshal(value, new(interface{}))
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakDataSourceOpenidClientInstallationProvider_jbossSubsystem(t *testing.T) {
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
+
+	dataSourceName := "data.keycloak_openid_client_installation_provider.keycloak_json"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakOpenidClientDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceKeycloakOpenidClientInstallationProvider(clientId, "keycloak-oidc-jboss-subsystem"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "provider_id", "keycloak-oidc-jboss-subsystem"),
+					resource.TestCheckResourceAttr(dataSourceName, "attributes.%", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "resource", ""),
+					testAccCheckDataKeycloakOpenidClientInstallationProvider(dataSourceName, func(value []byte) error {
+						return xml.Unmarshal(value, new(interface{}))
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDataKeycloakOpenidClientInstallationProvider(resourceName string, parse func([]byte) error) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		value := rs.Primary.Attributes["value"]
+
+		err := parse([]byte(value))
+		if err != nil {
+			return fmt.Errorf("unable to parse installation provider value: %s\n%s", err, value)
+		}
+
+		return nil
+	}
+}
+
+func testDataSourceKeycloakOpenidClientInstallationProvider(clientId, providerId string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client" "client" {
+	client_id   = "%s"
+	realm_id    = data.keycloak_realm.realm.id
+	access_type = "CONFIDENTIAL"
+}
+
+data "keycloak_openid_client_installation_provider" "keycloak_json" {
+  realm_id    = data.keycloak_realm.realm.id
+  client_id   = keycloak_openid_client.client.id
+  provider_id = "%s"
+}
+	`, testAccRealm.Realm, clientId, providerId)
+}
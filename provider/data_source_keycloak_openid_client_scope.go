@@ -37,6 +37,14 @@ func dataSourceKeycloakOpenidClientScope() *schema.Resource {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
+			"dynamic": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"dynamic_scope_regexp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -0,0 +1,76 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/charlesderek/terraform-w-keycloak/keycloak"
+)
+
+func dataSourceKeycloakOpenidClientSecret() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceKeycloakOpenidClientSecretRead,
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"client_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"client_secret": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"client_secret_created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"client_secret_expires_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"client_secret_rotated": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"client_secret_rotated_created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"client_secret_rotated_expires_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceKeycloakOpenidClientSecretRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	clientId := data.Get("client_id").(string)
+
+	client, err := keycloakClient.GetOpenidClient(ctx, realmId, clientId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	attributes, err := getOpenidClientSecretRotationAttributes(ctx, keycloakClient, client, true)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(client.Id)
+
+	for attribute, value := range attributes {
+		data.Set(attribute, value)
+	}
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccKeycloakDataSourceOpenidClientSecret_basic(t *testing.T) {
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
+
+	resourceName := "keycloak_openid_client.client"
+	dataSourceName := "data.keycloak_openid_client_secret.secret"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakOpenidClientDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceKeycloakOpenidClientSecret_basic(clientId),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "client_secret", resourceName, "client_secret"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "client_secret_rotated", resourceName, "client_secret_rotated"),
+					resource.TestCheckResourceAttr(dataSourceName, "client_secret_rotated", ""),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourceKeycloakOpenidClientSecret_basic(clientId string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client" "client" {
+	client_id   = "%s"
+	realm_id    = data.keycloak_realm.realm.id
+	access_type = "CONFIDENTIAL"
+}
+
+data "keycloak_openid_client_secret" "secret" {
+	realm_id  = data.keycloak_realm.realm.id
+	client_id = keycloak_openid_client.client.id
+}
+	`, testAccRealm.Realm, clientId)
+}
@@ -0,0 +1,229 @@
+package provider
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/charlesderek/terraform-w-keycloak/keycloak"
+)
+
+var keycloakOpenidTokenGrantTypes = map[string]string{
+	"client_credentials": keycloak.OpenidTokenGrantTypeClientCredentials,
+	"password":           keycloak.OpenidTokenGrantTypePassword,
+	"token_exchange":     keycloak.OpenidTokenGrantTypeTokenExchange,
+}
+
+func dataSourceKeycloakOpenidToken() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceKeycloakOpenidTokenRead,
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"client_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"client_secret": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+			"grant_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "client_credentials",
+				ValidateFunc: validation.StringInSlice([]string{"client_credentials", "password", "token_exchange"}, false),
+			},
+			"scopes": {
+				Type:     schema.TypeSet,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Optional: true,
+			},
+			"username": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"password": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+			"subject_token": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+			"subject_token_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "urn:ietf:params:oauth:token-type:access_token",
+			},
+			"requested_token_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"requested_subject": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"audience": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"access_token": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"refresh_token": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"id_token": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"token_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"expires_in": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"expires_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"refresh_expires_in": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"scope": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"claims": {
+				Type:     schema.TypeMap,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
+		},
+	}
+}
+
+func getOpenidTokenRequestFromData(data *schema.ResourceData) (*keycloak.OpenidTokenRequest, error) {
+	request := &keycloak.OpenidTokenRequest{
+		GrantType:          keycloakOpenidTokenGrantTypes[data.Get("grant_type").(string)],
+		ClientId:           data.Get("client_id").(string),
+		ClientSecret:       data.Get("client_secret").(string),
+		Scopes:             interfaceSliceToStringSlice(data.Get("scopes").(*schema.Set).List()),
+		Username:           data.Get("username").(string),
+		Password:           data.Get("password").(string),
+		SubjectToken:       data.Get("subject_token").(string),
+		SubjectTokenType:   data.Get("subject_token_type").(string),
+		RequestedTokenType: data.Get("requested_token_type").(string),
+		RequestedSubject:   data.Get("requested_subject").(string),
+		Audience:           data.Get("audience").(string),
+	}
+
+	switch data.Get("grant_type").(string) {
+	case "password":
+		if request.Username == "" || request.Password == "" {
+			return nil, fmt.Errorf("validation error: username and password are required for the password grant")
+		}
+	case "token_exchange":
+		if request.SubjectToken == "" && request.RequestedSubject == "" {
+			return nil, fmt.Errorf("validation error: subject_token or requested_subject is required for the token_exchange grant")
+		}
+	}
+
+	return request, nil
+}
+
+// getOpenidTokenClaims decodes the payload of a JWT without verifying it. claims that aren't strings are JSON encoded,
+// so they can be decoded again using `jsondecode`.
+func getOpenidTokenClaims(token string) (map[string]string, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, nil
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode token payload: %s", err)
+	}
+
+	var rawClaims map[string]interface{}
+
+	err = json.Unmarshal(payload, &rawClaims)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse token payload: %s", err)
+	}
+
+	claims := make(map[string]string, len(rawClaims))
+	for key, value := range rawClaims {
+		if stringValue, ok := value.(string); ok {
+			claims[key] = stringValue
+			continue
+		}
+
+		encoded, err := json.Marshal(value)
+		if err != nil {
+			return nil, err
+		}
+
+		claims[key] = string(encoded)
+	}
+
+	return claims, nil
+}
+
+func dataSourceKeycloakOpenidTokenRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+
+	request, err := getOpenidTokenRequestFromData(data)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	issuedAt := time.Now()
+
+	token, err := keycloakClient.GetOpenidToken(ctx, realmId, request)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	claims, err := getOpenidTokenClaims(token.AccessToken)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(fmt.Sprintf("%s/%s", realmId, request.ClientId))
+
+	data.Set("access_token", token.AccessToken)
+	data.Set("refresh_token", token.RefreshToken)
+	data.Set("id_token", token.IdToken)
+	data.Set("token_type", token.TokenType)
+	data.Set("expires_in", token.ExpiresIn)
+	data.Set("expires_at", issuedAt.Add(time.Duration(token.ExpiresIn)*time.Second).UTC().Format(time.RFC3339))
+	data.Set("refresh_expires_in", token.RefreshExpiresIn)
+	data.Set("scope", token.Scope)
+	data.Set("claims", claims)
+
+	return nil
+}
@@ -0,0 +1,141 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccKeycloakDataSourceOpenidToken_clientCredentials(t *testing.T) {
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
+	dataSourceName := "data.keycloak_openid_token.token"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeycloakOpenidToken_clientCredentials(clientId),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "access_token"),
+					resource.TestCheckResourceAttr(dataSourceName, "token_type", "Bearer"),
+					resource.TestCheckResourceAttr(dataSourceName, "claims.azp", clientId),
+					resource.TestCheckResourceAttrSet(dataSourceName, "expires_at"),
+					resource.TestMatchResourceAttr(dataSourceName, "scope", regexp.MustCompile("profile")),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakDataSourceOpenidToken_password(t *testing.T) {
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
+	username := acctest.RandomWithPrefix("tf-acc")
+	password := acctest.RandString(16)
+	dataSourceName := "data.keycloak_openid_token.token"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeycloakOpenidToken_password(clientId, username, password, password),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "access_token"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "refresh_token"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "id_token"),
+					resource.TestCheckResourceAttr(dataSourceName, "claims.preferred_username", username),
+				),
+			},
+			{
+				Config:      testAccKeycloakOpenidToken_password(clientId, username, password, "wrong-password"),
+				ExpectError: regexp.MustCompile("invalid_grant"),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakDataSourceOpenidToken_passwordMissingUsername(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+data "keycloak_openid_token" "token" {
+	realm_id   = "%s"
+	client_id  = "client"
+	grant_type = "password"
+}
+	`, testAccRealm.Realm),
+				ExpectError: regexp.MustCompile("username and password are required for the password grant"),
+			},
+		},
+	})
+}
+
+func testAccKeycloakOpenidToken_clientCredentials(clientId string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client" "client" {
+	realm_id                 = data.keycloak_realm.realm.id
+	client_id                = "%s"
+	access_type              = "CONFIDENTIAL"
+	service_accounts_enabled = true
+}
+
+data "keycloak_openid_token" "token" {
+	realm_id      = data.keycloak_realm.realm.id
+	client_id     = keycloak_openid_client.client.client_id
+	client_secret = keycloak_openid_client.client.client_secret
+	scopes        = ["profile"]
+}
+	`, testAccRealm.Realm, clientId)
+}
+
+func testAccKeycloakOpenidToken_password(clientId, username, password, grantPassword string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client" "client" {
+	realm_id                     = data.keycloak_realm.realm.id
+	client_id                    = "%s"
+	access_type                  = "PUBLIC"
+	direct_access_grants_enabled = true
+}
+
+resource "keycloak_user" "user" {
+	realm_id   = data.keycloak_realm.realm.id
+	username   = "%s"
+	email      = "%s@example.com"
+	first_name = "First"
+	last_name  = "Last"
+
+	initial_password {
+		value     = "%s"
+		temporary = false
+	}
+}
+
+data "keycloak_openid_token" "token" {
+	realm_id   = data.keycloak_realm.realm.id
+	client_id  = keycloak_openid_client.client.client_id
+	grant_type = "password"
+	username   = keycloak_user.user.username
+	password   = "%s"
+	scopes     = ["openid"]
+}
+	`, testAccRealm.Realm, clientId, username, username, password, grantPassword)
+}
@@ -0,0 +1,312 @@
+package provider
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/charlesderek/terraform-w-keycloak/keycloak"
+)
+
+func dataSourceKeycloakRealmJwks() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceKeycloakRealmJwksRead,
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"use": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"sig", "enc"}, false),
+				Description:  "When set, only keys with this use are returned.",
+			},
+			"json": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"keys": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"kid": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"kty": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"alg": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"use": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"n": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"e": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"crv": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"x": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"y": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"x5c": {
+							Type:     schema.TypeList,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Computed: true,
+						},
+						"x5t": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"x5t_s256": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"public_key_pem": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"certificate_pem": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func decodeJwkParameter(value string) (*big.Int, error) {
+	decoded, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(big.Int).SetBytes(decoded), nil
+}
+
+// getJwkPublicKey converts the public key parameters of a jwk to a go public key. keys that can't be represented by the
+// standard library, such as Ed448 keys, return nil.
+func getJwkPublicKey(jwk *keycloak.JsonWebKey) (interface{}, error) {
+	switch jwk.Kty {
+	case "RSA":
+		n, err := decodeJwkParameter(jwk.N)
+		if err != nil {
+			return nil, err
+		}
+
+		e, err := decodeJwkParameter(jwk.E)
+		if err != nil {
+			return nil, err
+		}
+
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch jwk.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, nil
+		}
+
+		x, err := decodeJwkParameter(jwk.X)
+		if err != nil {
+			return nil, err
+		}
+
+		y, err := decodeJwkParameter(jwk.Y)
+		if err != nil {
+			return nil, err
+		}
+
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	case "OKP":
+		if jwk.Crv != "Ed25519" {
+			return nil, nil
+		}
+
+		x, err := base64.RawURLEncoding.DecodeString(jwk.X)
+		if err != nil {
+			return nil, err
+		}
+
+		return ed25519.PublicKey(x), nil
+	}
+
+	return nil, nil
+}
+
+func getJwkPublicKeyPem(jwk *keycloak.JsonWebKey) (string, error) {
+	publicKey, err := getJwkPublicKey(jwk)
+	if err != nil || publicKey == nil {
+		return "", err
+	}
+
+	der, err := x509.MarshalPKIXPublicKey(publicKey)
+	if err != nil {
+		return "", err
+	}
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})), nil
+}
+
+func getJwkCertificatePem(jwk *keycloak.JsonWebKey) (string, error) {
+	if len(jwk.X5c) == 0 {
+		return "", nil
+	}
+
+	der, err := base64.StdEncoding.DecodeString(jwk.X5c[0])
+	if err != nil {
+		return "", err
+	}
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})), nil
+}
+
+func flattenJwks(keys []*keycloak.JsonWebKey) ([]interface{}, error) {
+	var result []interface{}
+	for _, jwk := range keys {
+		publicKeyPem, err := getJwkPublicKeyPem(jwk)
+		if err != nil {
+			return nil, fmt.Errorf("could not convert key %s to PEM: %s", jwk.Kid, err)
+		}
+
+		certificatePem, err := getJwkCertificatePem(jwk)
+		if err != nil {
+			return nil, fmt.Errorf("could not convert certificate of key %s to PEM: %s", jwk.Kid, err)
+		}
+
+		result = append(result, map[string]interface{}{
+			"kid":             jwk.Kid,
+			"kty":             jwk.Kty,
+			"alg":             jwk.Alg,
+			"use":             jwk.Use,
+			"n":               jwk.N,
+			"e":               jwk.E,
+			"crv":             jwk.Crv,
+			"x":               jwk.X,
+			"y":               jwk.Y,
+			"x5c":             jwk.X5c,
+			"x5t":             jwk.X5t,
+			"x5t_s256":        jwk.X5tS256,
+			"public_key_pem":  publicKeyPem,
+			"certificate_pem": certificatePem,
+		})
+	}
+
+	return result, nil
+}
+
+// filterJwksJson removes the keys that aren't meant for use from the raw key set. the keys are filtered as raw json, so
+// members that JsonWebKey doesn't know about are kept as is.
+func filterJwksJson(raw, use string) (string, error) {
+	var keySet map[string]json.RawMessage
+	err := json.Unmarshal([]byte(raw), &keySet)
+	if err != nil {
+		return "", err
+	}
+
+	var keys []json.RawMessage
+	err = json.Unmarshal(keySet["keys"], &keys)
+	if err != nil {
+		return "", err
+	}
+
+	filteredKeys := make([]json.RawMessage, 0, len(keys))
+	for _, key := range keys {
+		var jwk keycloak.JsonWebKey
+		err = json.Unmarshal(key, &jwk)
+		if err != nil {
+			return "", err
+		}
+
+		if jwk.Use == use {
+			filteredKeys = append(filteredKeys, key)
+		}
+	}
+
+	keySet["keys"], err = json.Marshal(filteredKeys)
+	if err != nil {
+		return "", err
+	}
+
+	filtered, err := json.Marshal(keySet)
+	if err != nil {
+		return "", err
+	}
+
+	return string(filtered), nil
+}
+
+func dataSourceKeycloakRealmJwksRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+
+	jwks, err := keycloakClient.GetRealmJwks(ctx, realmId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	keys := jwks.Keys
+	jwksJson := jwks.Raw
+	if use, ok := data.GetOk("use"); ok {
+		keys = nil
+		for _, jwk := range jwks.Keys {
+			if jwk.Use == use.(string) {
+				keys = append(keys, jwk)
+			}
+		}
+
+		jwksJson, err = filterJwksJson(jwks.Raw, use.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	flattenedKeys, err := flattenJwks(keys)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(realmId)
+	data.Set("json", jwksJson)
+	data.Set("keys", flattenedKeys)
+
+	return nil
+}
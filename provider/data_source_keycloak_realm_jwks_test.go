@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccKeycloakDataSourceRealmJwks_basic(t *testing.T) {
+	t.Parallel()
+	dataSourceName := "data.keycloak_realm_jwks.jwks"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeycloakRealmJwksConfig(""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "keys.0.kid"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "json"),
+				),
+			},
+			{
+				Config: testAccKeycloakRealmJwksConfig("sig"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "keys.0.use", "sig"),
+					resource.TestCheckResourceAttrWith(dataSourceName, "json", func(value string) error {
+						if strings.Contains(value, `"use":"enc"`) {
+							return fmt.Errorf("expected json to only contain sig keys, got %s", value)
+						}
+
+						return nil
+					}),
+					resource.TestMatchResourceAttr(dataSourceName, "keys.0.public_key_pem", regexp.MustCompile("^-----BEGIN PUBLIC KEY-----\n")),
+					// the default RS256 key of a realm is published with its certificate
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "keys.*", map[string]string{
+						"kty": "RSA",
+						"alg": "RS256",
+					}),
+					resource.TestMatchTypeSetElemNestedAttrs(dataSourceName, "keys.*", map[string]*regexp.Regexp{
+						"certificate_pem": regexp.MustCompile("^-----BEGIN CERTIFICATE-----\n"),
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccKeycloakRealmJwksConfig(use string) string {
+	useAttribute := ""
+	if use != "" {
+		useAttribute = fmt.Sprintf(`use      = "%s"`, use)
+	}
+
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+data "keycloak_realm_jwks" "jwks" {
+	realm_id = data.keycloak_realm.realm.id
+	%s
+}
+`, testAccRealm.Realm, useAttribute)
+}
@@ -2,7 +2,6 @@ package provider
 
 import (
 	"context"
-	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -73,6 +72,18 @@ func dataSourceKeycloakRealmKeys() *schema.Resource {
 							Computed: true,
 							Optional: true,
 						},
+						"certificate_not_after": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"certificate_subject": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"certificate_fingerprint_sha256": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
 					},
 				},
 			},
@@ -80,7 +91,9 @@ func dataSourceKeycloakRealmKeys() *schema.Resource {
 	}
 }
 
-func flattenRealmKeys(realmKeys []keycloak.Key) []map[string]interface{} {
+func flattenRealmKeys(realmKeys []keycloak.Key) ([]map[string]interface{}, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
 	keyMap := make([]map[string]interface{}, 0)
 	for _, key := range realmKeys {
 		element := make(map[string]interface{})
@@ -89,6 +102,15 @@ func flattenRealmKeys(realmKeys []keycloak.Key) []map[string]interface{} {
 		}
 		if key.Certificate != nil {
 			element["certificate"] = key.Certificate
+
+			certificate, err := parseRealmKeystoreCertificate(*key.Certificate)
+			if err != nil {
+				diags = append(diags, getRealmKeystoreCertificateParseWarning(StringValue(key.Kid), err))
+			} else {
+				for attribute, value := range getRealmKeystoreCertificateAttributes(certificate) {
+					element[attribute] = value
+				}
+			}
 		}
 		if key.ProviderId != nil {
 			element["provider_id"] = key.ProviderId
@@ -111,18 +133,20 @@ func flattenRealmKeys(realmKeys []keycloak.Key) []map[string]interface{} {
 
 		keyMap = append(keyMap, element)
 	}
-	return keyMap
+	return keyMap, diags
 }
 
-func setRealmKeysData(data *schema.ResourceData, keys *keycloak.Keys) error {
+func setRealmKeysData(data *schema.ResourceData, keys *keycloak.Keys) diag.Diagnostics {
 	data.SetId(data.Get("realm_id").(string))
 
-	err := data.Set("keys", flattenRealmKeys(keys.Keys))
+	flattenedKeys, diags := flattenRealmKeys(keys.Keys)
+
+	err := data.Set("keys", flattenedKeys)
 	if err != nil {
-		return fmt.Errorf("could not set 'keys' with values '%+v'\n%+v", keys.Keys, err)
+		return append(diags, diag.Errorf("could not set 'keys' with values '%+v'\n%+v", keys.Keys, err)...)
 	}
 
-	return nil
+	return diags
 }
 
 func dataSourceKeycloakRealmKeysRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -150,7 +174,7 @@ func dataSourceKeycloakRealmKeysRead(ctx context.Context, data *schema.ResourceD
 		}}
 	}
 
-	return diag.FromErr(setRealmKeysData(data, keys))
+	return setRealmKeysData(data, keys)
 }
 
 func filterKeys(allValues []keycloak.Key, filterAttribute string, allowedValues *schema.Set) []keycloak.Key {
@@ -2,6 +2,7 @@ package provider
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -40,6 +41,26 @@ func TestAccKeycloakDataSourceRealmKeys_filterByAlgorithms(t *testing.T) {
 	})
 }
 
+func TestAccKeycloakDataSourceRealmKeys_certificateAttributes(t *testing.T) {
+	t.Parallel()
+	dataSourceName := "data.keycloak_realm_keys.test_keys"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeycloakRealmKeysConfig_filterByRsaAlgorithm(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "keys.0.certificate_not_after"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "keys.0.certificate_subject"),
+					resource.TestMatchResourceAttr(dataSourceName, "keys.0.certificate_fingerprint_sha256", regexp.MustCompile("^[0-9a-f]{64}$")),
+				),
+			},
+		},
+	})
+}
+
 func getRealmKeysUsingState(state *terraform.State, resourceName string) (*terraform.ResourceState, error) {
 	rs, ok := state.RootModule().Resources[resourceName]
 	if !ok {
@@ -108,3 +129,16 @@ data "keycloak_realm_keys" "test_keys" {
 }
 `, testAccRealm.Realm)
 }
+
+func testAccKeycloakRealmKeysConfig_filterByRsaAlgorithm() string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+data "keycloak_realm_keys" "test_keys" {
+	realm_id   = data.keycloak_realm.realm.id
+	algorithms = ["RS256"]
+}
+`, testAccRealm.Realm)
+}
@@ -0,0 +1,116 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/charlesderek/terraform-w-keycloak/keycloak"
+)
+
+func dataSourceKeycloakRealmOpenidConfiguration() *schema.Resource {
+	stringAttributes := []string{
+		"issuer",
+		"authorization_endpoint",
+		"token_endpoint",
+		"introspection_endpoint",
+		"userinfo_endpoint",
+		"end_session_endpoint",
+		"jwks_uri",
+		"registration_endpoint",
+		"revocation_endpoint",
+		"device_authorization_endpoint",
+		"backchannel_authentication_endpoint",
+		"pushed_authorization_request_endpoint",
+		"check_session_iframe",
+		"json",
+	}
+
+	listAttributes := []string{
+		"grant_types_supported",
+		"response_types_supported",
+		"response_modes_supported",
+		"subject_types_supported",
+		"scopes_supported",
+		"claims_supported",
+		"id_token_signing_alg_values_supported",
+		"id_token_encryption_alg_values_supported",
+		"id_token_encryption_enc_values_supported",
+		"token_endpoint_auth_methods_supported",
+		"token_endpoint_auth_signing_alg_values_supported",
+		"code_challenge_methods_supported",
+	}
+
+	dataSourceSchema := map[string]*schema.Schema{
+		"realm_id": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+	}
+
+	for _, attribute := range stringAttributes {
+		dataSourceSchema[attribute] = &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		}
+	}
+
+	for _, attribute := range listAttributes {
+		dataSourceSchema[attribute] = &schema.Schema{
+			Type:     schema.TypeList,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+			Computed: true,
+		}
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceKeycloakRealmOpenidConfigurationRead,
+		Schema:      dataSourceSchema,
+	}
+}
+
+func setRealmOpenidConfigurationData(data *schema.ResourceData, openidConfiguration *keycloak.RealmOpenidConfiguration) {
+	data.Set("issuer", openidConfiguration.Issuer)
+	data.Set("authorization_endpoint", openidConfiguration.AuthorizationEndpoint)
+	data.Set("token_endpoint", openidConfiguration.TokenEndpoint)
+	data.Set("introspection_endpoint", openidConfiguration.IntrospectionEndpoint)
+	data.Set("userinfo_endpoint", openidConfiguration.UserinfoEndpoint)
+	data.Set("end_session_endpoint", openidConfiguration.EndSessionEndpoint)
+	data.Set("jwks_uri", openidConfiguration.JwksUri)
+	data.Set("registration_endpoint", openidConfiguration.RegistrationEndpoint)
+	data.Set("revocation_endpoint", openidConfiguration.RevocationEndpoint)
+	data.Set("device_authorization_endpoint", openidConfiguration.DeviceAuthorizationEndpoint)
+	data.Set("backchannel_authentication_endpoint", openidConfiguration.BackchannelAuthenticationEndpoint)
+	data.Set("pushed_authorization_request_endpoint", openidConfiguration.PushedAuthorizationRequestEndpoint)
+	data.Set("check_session_iframe", openidConfiguration.CheckSessionIframe)
+	data.Set("json", openidConfiguration.Raw)
+
+	data.Set("grant_types_supported", openidConfiguration.GrantTypesSupported)
+	data.Set("response_types_supported", openidConfiguration.ResponseTypesSupported)
+	data.Set("response_modes_supported", openidConfiguration.ResponseModesSupported)
+	data.Set("subject_types_supported", openidConfiguration.SubjectTypesSupported)
+	data.Set("scopes_supported", openidConfiguration.ScopesSupported)
+	data.Set("claims_supported", openidConfiguration.ClaimsSupported)
+	data.Set("id_token_signing_alg_values_supported", openidConfiguration.IdTokenSigningAlgValuesSupported)
+	data.Set("id_token_encryption_alg_values_supported", openidConfiguration.IdTokenEncryptionAlgValuesSupported)
+	data.Set("id_token_encryption_enc_values_supported", openidConfiguration.IdTokenEncryptionEncValuesSupported)
+	data.Set("token_endpoint_auth_methods_supported", openidConfiguration.TokenEndpointAuthMethodsSupported)
+	data.Set("token_endpoint_auth_signing_alg_values_supported", openidConfiguration.TokenEndpointAuthSigningAlgValuesSupported)
+	data.Set("code_challenge_methods_supported", openidConfiguration.CodeChallengeMethodsSupported)
+}
+
+func dataSourceKeycloakRealmOpenidConfigurationRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+
+	openidConfiguration, err := keycloakClient.GetRealmOpenidConfiguration(ctx, realmId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(realmId)
+	setRealmOpenidConfigurationData(data, openidConfiguration)
+
+	return nil
+}
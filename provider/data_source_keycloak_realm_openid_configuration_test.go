@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccKeycloakDataSourceRealmOpenidConfiguration_basic(t *testing.T) {
+	t.Parallel()
+	dataSourceName := "data.keycloak_realm_openid_configuration.openid_configuration"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeycloakRealmOpenidConfigurationConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(dataSourceName, "issuer", regexp.MustCompile(fmt.Sprintf("/realms/%s$", testAccRealm.Realm))),
+					resource.TestMatchResourceAttr(dataSourceName, "token_endpoint", regexp.MustCompile("/protocol/openid-connect/token$")),
+					resource.TestMatchResourceAttr(dataSourceName, "jwks_uri", regexp.MustCompile("/protocol/openid-connect/certs$")),
+					resource.TestCheckResourceAttrSet(dataSourceName, "grant_types_supported.#"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "json"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakDataSourceRealmOpenidConfiguration_realmNotFound(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccKeycloakRealmOpenidConfigurationConfig_realmNotFound(),
+				ExpectError: regexp.MustCompile("404 Not Found"),
+			},
+		},
+	})
+}
+
+func testAccKeycloakRealmOpenidConfigurationConfig() string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+data "keycloak_realm_openid_configuration" "openid_configuration" {
+	realm_id = data.keycloak_realm.realm.id
+}
+`, testAccRealm.Realm)
+}
+
+func testAccKeycloakRealmOpenidConfigurationConfig_realmNotFound() string {
+	return `
+data "keycloak_realm_openid_configuration" "openid_configuration" {
+	realm_id = "tf-acc-realm-does-not-exist"
+}
+`
+}
@@ -58,6 +58,12 @@ func setExtraConfigData(data *schema.ResourceData, extraConfig map[string]interf
 // validateExtraConfig takes a reflect value type to check its JSON schema in order to validate that extra_config
 // doesn't contain any attributes that could have been specified within the official schema
 func validateExtraConfig(reflectValue reflect.Value) schema.SchemaValidateDiagFunc {
+	return validateExtraConfigWithLegacyKeys(reflectValue, nil)
+}
+
+// validateExtraConfigWithLegacyKeys works like validateExtraConfig, but only warns about the keys of legacyKeys, which
+// map keys that used to be set through extra_config to the attribute that replaced them
+func validateExtraConfigWithLegacyKeys(reflectValue reflect.Value, legacyKeys map[string]string) schema.SchemaValidateDiagFunc {
 	return func(v interface{}, path cty.Path) diag.Diagnostics {
 		var diags diag.Diagnostics
 
@@ -69,13 +75,25 @@ func validateExtraConfig(reflectValue reflect.Value) schema.SchemaValidateDiagFu
 
 			if jsonKey != "-" && field.CanSet() {
 				if _, ok := extraConfig[jsonKey]; ok {
+					attributePath := append(path, cty.IndexStep{
+						Key: cty.StringVal(jsonKey),
+					})
+
+					if attribute, ok := legacyKeys[jsonKey]; ok {
+						diags = append(diags, diag.Diagnostic{
+							Severity:      diag.Warning,
+							Summary:       "Deprecated extra_config key",
+							Detail:        fmt.Sprintf(`extra_config key "%s" is deprecated, use the %s attribute instead. The key is ignored when %s is set`, jsonKey, attribute, attribute),
+							AttributePath: attributePath,
+						})
+						continue
+					}
+
 					diags = append(diags, diag.Diagnostic{
-						Severity: diag.Error,
-						Summary:  "Invalid extra_config key",
-						Detail:   fmt.Sprintf(`extra_config key "%s" is not allowed, as it conflicts with a top-level schema attribute`, jsonKey),
-						AttributePath: append(path, cty.IndexStep{
-							Key: cty.StringVal(jsonKey),
-						}),
+						Severity:      diag.Error,
+						Summary:       "Invalid extra_config key",
+						Detail:        fmt.Sprintf(`extra_config key "%s" is not allowed, as it conflicts with a top-level schema attribute`, jsonKey),
+						AttributePath: attributePath,
 					})
 				}
 			}
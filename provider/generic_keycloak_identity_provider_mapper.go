@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"strings"
@@ -69,6 +70,74 @@ func setIdentityProviderMapperData(data *schema.ResourceData, identityProviderMa
 	return nil
 }
 
+// identityProviderMapperKeyValue is the representation used by Keycloak's advanced mappers for a single claim or
+// attribute to match. a list of these is stored as a JSON encoded string within the mapper's config.
+type identityProviderMapperKeyValue struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+func identityProviderMapperKeyValueSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeSet,
+		Required:    true,
+		MinItems:    1,
+		Description: description,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"key": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"value": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Default:  "",
+				},
+			},
+		},
+	}
+}
+
+func getIdentityProviderMapperKeyValuesFromData(data *schema.ResourceData, attributeName string) (string, error) {
+	keyValues := make([]identityProviderMapperKeyValue, 0)
+	for _, kv := range data.Get(attributeName).(*schema.Set).List() {
+		kvMap := kv.(map[string]interface{})
+		keyValues = append(keyValues, identityProviderMapperKeyValue{
+			Key:   kvMap["key"].(string),
+			Value: kvMap["value"].(string),
+		})
+	}
+
+	keyValuesJson, err := json.Marshal(keyValues)
+	if err != nil {
+		return "", err
+	}
+
+	return string(keyValuesJson), nil
+}
+
+// setIdentityProviderMapperKeyValuesData parses the JSON encoded list of claims or attributes from Keycloak, so whitespace
+// or ordering differences in the stored string don't show up as a diff
+func setIdentityProviderMapperKeyValuesData(data *schema.ResourceData, attributeName, keyValuesJson string) error {
+	var keyValues []identityProviderMapperKeyValue
+	if keyValuesJson != "" {
+		if err := json.Unmarshal([]byte(keyValuesJson), &keyValues); err != nil {
+			return fmt.Errorf("unable to parse %s from identity provider mapper config: %s", attributeName, err)
+		}
+	}
+
+	var keyValuesData []interface{}
+	for _, kv := range keyValues {
+		keyValuesData = append(keyValuesData, map[string]interface{}{
+			"key":   kv.Key,
+			"value": kv.Value,
+		})
+	}
+
+	return data.Set(attributeName, keyValuesData)
+}
+
 func resourceKeycloakIdentityProviderMapperDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
@@ -0,0 +1,150 @@
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/imdario/mergo"
+	"github.com/charlesderek/terraform-w-keycloak/keycloak"
+	"github.com/charlesderek/terraform-w-keycloak/keycloak/types"
+)
+
+type socialIdentityProviderConfigGetterFunc func(data *schema.ResourceData, config *keycloak.IdentityProviderConfig)
+type socialIdentityProviderConfigSetterFunc func(data *schema.ResourceData, config *keycloak.IdentityProviderConfig)
+
+// resourceKeycloakSocialIdentityProvider builds a resource for one of Keycloak's built-in social identity providers.
+// these all share the same base schema, and only differ by their provider id and a handful of provider specific config keys.
+func resourceKeycloakSocialIdentityProvider(providerId string, providerSchema map[string]*schema.Schema, getConfigFromData socialIdentityProviderConfigGetterFunc, setConfigData socialIdentityProviderConfigSetterFunc) *schema.Resource {
+	socialSchema := map[string]*schema.Schema{
+		"alias": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			ForceNew:    true,
+			Default:     providerId,
+			Description: "The alias uniquely identifies an identity provider and it is also used to build the redirect uri. Defaults to the provider id.",
+		},
+		"provider_id": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			ForceNew:    true,
+			Default:     providerId,
+			Description: "provider id, is always " + providerId + ", unless you have a extended custom implementation",
+		},
+		"client_id": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Client ID.",
+		},
+		"client_secret": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Sensitive:   true,
+			Description: "Client Secret.",
+		},
+		"default_scopes": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "",
+			Description: "The scopes to be sent when asking for authorization. When empty, the provider specific default scopes are used.",
+		},
+		"hide_on_login_page": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Hide On Login Page.",
+		},
+	}
+
+	socialResource := resourceKeycloakIdentityProvider()
+	socialResource.Schema = mergeSchemas(socialResource.Schema, socialSchema)
+	socialResource.Schema = mergeSchemas(socialResource.Schema, providerSchema)
+
+	getSocialIdentityProviderFromData := getSocialIdentityProviderFromDataFunc(getConfigFromData)
+	setSocialIdentityProviderData := setSocialIdentityProviderDataFunc(setConfigData)
+
+	socialResource.CreateContext = resourceKeycloakSocialIdentityProviderValidate(resourceKeycloakIdentityProviderCreate(getSocialIdentityProviderFromData, setSocialIdentityProviderData))
+	socialResource.ReadContext = resourceKeycloakIdentityProviderRead(setSocialIdentityProviderData)
+	socialResource.UpdateContext = resourceKeycloakSocialIdentityProviderValidate(resourceKeycloakIdentityProviderUpdate(getSocialIdentityProviderFromData, setSocialIdentityProviderData))
+
+	return socialResource
+}
+
+func getSocialIdentityProviderFromDataFunc(getConfigFromData socialIdentityProviderConfigGetterFunc) identityProviderDataGetterFunc {
+	return func(data *schema.ResourceData) (*keycloak.IdentityProvider, error) {
+		rec, defaultConfig := getIdentityProviderFromData(data)
+		rec.ProviderId = data.Get("provider_id").(string)
+
+		socialIdentityProviderConfig := &keycloak.IdentityProviderConfig{
+			ClientId:        data.Get("client_id").(string),
+			ClientSecret:    data.Get("client_secret").(string),
+			DefaultScope:    data.Get("default_scopes").(string),
+			HideOnLoginPage: types.KeycloakBoolQuoted(data.Get("hide_on_login_page").(bool)),
+		}
+
+		if getConfigFromData != nil {
+			getConfigFromData(data, socialIdentityProviderConfig)
+		}
+
+		if err := mergo.Merge(socialIdentityProviderConfig, defaultConfig); err != nil {
+			return nil, err
+		}
+
+		rec.Config = socialIdentityProviderConfig
+
+		return rec, nil
+	}
+}
+
+func setSocialIdentityProviderDataFunc(setConfigData socialIdentityProviderConfigSetterFunc) identityProviderDataSetterFunc {
+	return func(data *schema.ResourceData, identityProvider *keycloak.IdentityProvider) error {
+		// the provider specific config is taken out of the extra config first, so it doesn't show up in extra_config
+		if setConfigData != nil {
+			setConfigData(data, identityProvider.Config)
+		}
+
+		setIdentityProviderData(data, identityProvider)
+		data.Set("provider_id", identityProvider.ProviderId)
+		data.Set("client_id", identityProvider.Config.ClientId)
+		data.Set("default_scopes", identityProvider.Config.DefaultScope)
+		data.Set("hide_on_login_page", identityProvider.Config.HideOnLoginPage)
+
+		return nil
+	}
+}
+
+// setSocialIdentityProviderConfigValue stores a provider specific config key. these keys only apply to a single social
+// provider, so they're kept in the extra config rather than in IdentityProviderConfig, which every identity provider
+// shares. empty values are left out, the same way as the omitempty keys of IdentityProviderConfig.
+func setSocialIdentityProviderConfigValue(config *keycloak.IdentityProviderConfig, key, value string) {
+	if value == "" {
+		return
+	}
+
+	if config.ExtraConfig == nil {
+		config.ExtraConfig = map[string]interface{}{}
+	}
+
+	config.ExtraConfig[key] = value
+}
+
+// getSocialIdentityProviderConfigValue takes a provider specific config key out of the extra config
+func getSocialIdentityProviderConfigValue(config *keycloak.IdentityProviderConfig, key string) string {
+	value, _ := config.ExtraConfig[key].(string)
+	delete(config.ExtraConfig, key)
+
+	return value
+}
+
+// resourceKeycloakSocialIdentityProviderValidate ensures that the social provider is installed on the server before
+// attempting to create or update it, since Keycloak will otherwise accept the request and fail at login time
+func resourceKeycloakSocialIdentityProviderValidate(next func(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics) func(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return func(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		keycloakClient := meta.(*keycloak.KeycloakClient)
+
+		if err := keycloakClient.ValidateSocialIdentityProvider(ctx, data.Get("provider_id").(string)); err != nil {
+			return diag.FromErr(err)
+		}
+
+		return next(ctx, data, meta)
+	}
+}
@@ -0,0 +1,179 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/charlesderek/terraform-w-keycloak/keycloak"
+	"testing"
+)
+
+// the social identity providers without any provider specific arguments only differ by their resource type and provider
+// id, so they're tested together. providers with their own arguments have their own tests.
+var testAccSocialIdentityProviders = []struct {
+	name       string
+	providerId string
+}{
+	{name: "bitbucket", providerId: "bitbucket"},
+	{name: "gitlab", providerId: "gitlab"},
+	{name: "instagram", providerId: "instagram"},
+	{name: "linkedin", providerId: "linkedin-openid-connect"},
+	{name: "twitter", providerId: "twitter"},
+}
+
+func TestAccKeycloakSocialIdentityProvider_basic(t *testing.T) {
+	t.Parallel()
+
+	for _, socialIdentityProvider := range testAccSocialIdentityProviders {
+		socialIdentityProvider := socialIdentityProvider
+
+		t.Run(socialIdentityProvider.name, func(t *testing.T) {
+			t.Parallel()
+
+			alias := acctest.RandomWithPrefix("tf-acc")
+			resourceType := fmt.Sprintf("keycloak_%s_identity_provider", socialIdentityProvider.name)
+			resourceName := resourceType + "." + socialIdentityProvider.name
+
+			resource.Test(t, resource.TestCase{
+				ProviderFactories: testAccProviderFactories,
+				PreCheck:          func() { testAccPreCheck(t) },
+				CheckDestroy:      testAccCheckKeycloakSocialIdentityProviderDestroy(resourceType),
+				Steps: []resource.TestStep{
+					{
+						Config: testKeycloakSocialIdentityProvider_basic(socialIdentityProvider.name, alias),
+						Check:  testAccCheckKeycloakSocialIdentityProviderExists(resourceName, socialIdentityProvider.providerId),
+					},
+					{
+						ResourceName:            resourceName,
+						ImportState:             true,
+						ImportStateVerify:       true,
+						ImportStateIdPrefix:     testAccRealm.Realm + "/",
+						ImportStateVerifyIgnore: []string{"client_secret"},
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestAccKeycloakSocialIdentityProvider_createAfterManualDestroy(t *testing.T) {
+	t.Parallel()
+
+	for _, socialIdentityProvider := range testAccSocialIdentityProviders {
+		socialIdentityProvider := socialIdentityProvider
+
+		t.Run(socialIdentityProvider.name, func(t *testing.T) {
+			t.Parallel()
+
+			var idp = &keycloak.IdentityProvider{}
+			alias := acctest.RandomWithPrefix("tf-acc")
+			resourceType := fmt.Sprintf("keycloak_%s_identity_provider", socialIdentityProvider.name)
+			resourceName := resourceType + "." + socialIdentityProvider.name
+
+			resource.Test(t, resource.TestCase{
+				ProviderFactories: testAccProviderFactories,
+				PreCheck:          func() { testAccPreCheck(t) },
+				CheckDestroy:      testAccCheckKeycloakSocialIdentityProviderDestroy(resourceType),
+				Steps: []resource.TestStep{
+					{
+						Config: testKeycloakSocialIdentityProvider_basic(socialIdentityProvider.name, alias),
+						Check:  testAccCheckKeycloakSocialIdentityProviderFetch(resourceName, idp),
+					},
+					{
+						PreConfig: func() {
+							err := keycloakClient.DeleteIdentityProvider(testCtx, idp.Realm, idp.Alias)
+							if err != nil {
+								t.Fatal(err)
+							}
+						},
+						Config: testKeycloakSocialIdentityProvider_basic(socialIdentityProvider.name, alias),
+						Check:  testAccCheckKeycloakSocialIdentityProviderExists(resourceName, socialIdentityProvider.providerId),
+					},
+				},
+			})
+		})
+	}
+}
+
+func testAccCheckKeycloakSocialIdentityProviderExists(resourceName, providerId string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		idp, err := getKeycloakSocialIdentityProviderFromState(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		if idp.ProviderId != providerId {
+			return fmt.Errorf("expected identity provider %s to have provider id %s, but was %s", idp.Alias, providerId, idp.ProviderId)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKeycloakSocialIdentityProviderFetch(resourceName string, idp *keycloak.IdentityProvider) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		fetchedIdp, err := getKeycloakSocialIdentityProviderFromState(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		idp.Alias = fetchedIdp.Alias
+		idp.Realm = fetchedIdp.Realm
+		idp.Config = fetchedIdp.Config
+
+		return nil
+	}
+}
+
+func testAccCheckKeycloakSocialIdentityProviderDestroy(resourceType string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != resourceType {
+				continue
+			}
+
+			id := rs.Primary.ID
+			realm := rs.Primary.Attributes["realm"]
+
+			idp, _ := keycloakClient.GetIdentityProvider(testCtx, realm, id)
+			if idp != nil {
+				return fmt.Errorf("%s with id %s still exists", resourceType, id)
+			}
+		}
+
+		return nil
+	}
+}
+
+func getKeycloakSocialIdentityProviderFromState(s *terraform.State, resourceName string) (*keycloak.IdentityProvider, error) {
+	rs, ok := s.RootModule().Resources[resourceName]
+	if !ok {
+		return nil, fmt.Errorf("resource not found: %s", resourceName)
+	}
+
+	realm := rs.Primary.Attributes["realm"]
+	alias := rs.Primary.Attributes["alias"]
+
+	idp, err := keycloakClient.GetIdentityProvider(testCtx, realm, alias)
+	if err != nil {
+		return nil, fmt.Errorf("error getting identity provider with alias %s: %s", alias, err)
+	}
+
+	return idp, nil
+}
+
+func testKeycloakSocialIdentityProvider_basic(name, alias string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_%s_identity_provider" "%s" {
+	realm         = data.keycloak_realm.realm.id
+	alias         = "%s"
+	client_id     = "example_id"
+	client_secret = "example_token"
+}
+	`, testAccRealm.Realm, name, name, alias)
+}
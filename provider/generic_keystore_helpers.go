@@ -4,9 +4,21 @@ import (
 	"context"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/charlesderek/terraform-w-keycloak/keycloak"
 	"strings"
 )
 
+// keystore providers that are not available in every version of keycloak supported by this provider
+var keycloakRealmKeystoreMinimumVersions = map[string]keycloak.Version{
+	"eddsa-generated": keycloak.Version_23,
+}
+
+// keystore providers whose algorithm has to be supported by one of the server's providers of the given type
+var keycloakRealmKeystoreAlgorithmProviderTypes = map[string]string{
+	"rsa-enc":           "cekmanagement",
+	"rsa-enc-generated": "cekmanagement",
+}
+
 func resourceKeycloakRealmKeystoreGenericImport(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
 	parts := strings.Split(d.Id(), "/")
 
@@ -19,3 +31,47 @@ func resourceKeycloakRealmKeystoreGenericImport(_ context.Context, d *schema.Res
 
 	return []*schema.ResourceData{d}, nil
 }
+
+// validateRealmKeystoreVersionCustomizeDiff returns an error at plan time if the keystore provider isn't available on
+// the server, instead of failing with a generic error from the components endpoint during apply
+func validateRealmKeystoreVersionCustomizeDiff(providerId string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		minimumVersion, ok := keycloakRealmKeystoreMinimumVersions[providerId]
+		if !ok {
+			return nil
+		}
+
+		keycloakClient := meta.(*keycloak.KeycloakClient)
+
+		supported, err := keycloakClient.VersionIsGreaterThanOrEqualTo(ctx, minimumVersion)
+		if err != nil {
+			return err
+		}
+
+		if !supported {
+			return fmt.Errorf("validation error: %s keystores are only supported by Keycloak %s and later", providerId, minimumVersion)
+		}
+
+		return nil
+	}
+}
+
+// validateRealmKeystoreAlgorithmCustomizeDiff returns an error at plan time if the algorithm of the keystore isn't
+// supported by the server, the same way as the algorithms of openid clients
+func validateRealmKeystoreAlgorithmCustomizeDiff(providerId string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		providerType, ok := keycloakRealmKeystoreAlgorithmProviderTypes[providerId]
+		if !ok || !d.NewValueKnown("algorithm") {
+			return nil
+		}
+
+		keycloakClient := meta.(*keycloak.KeycloakClient)
+
+		serverInfo, err := keycloakClient.GetServerInfo(ctx)
+		if err != nil {
+			return err
+		}
+
+		return serverInfo.ValidateAlgorithm(providerId+" keystore", providerType, d.Get("algorithm").(string))
+	}
+}
@@ -3,7 +3,9 @@ package provider
 import (
 	"context"
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/charlesderek/terraform-w-keycloak/keycloak"
 	"strings"
 )
 
@@ -29,3 +31,81 @@ func genericProtocolMapperImport(_ context.Context, data *schema.ResourceData, _
 
 	return []*schema.ResourceData{data}, nil
 }
+
+// validateGenericProtocolMapperCustomizeDiff validates the `protocol_mapper` and `config` attributes against the protocol
+// mapper types reported by the server. values that are unknown at plan time are checked when the mapper is created.
+// config keys that the mapper doesn't describe are only reported when applying, since a diff can't have warnings.
+func validateGenericProtocolMapperCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	rawConfig := d.GetRawConfig()
+	if rawConfig.IsNull() {
+		return nil
+	}
+
+	if !rawConfig.GetAttr("protocol").IsKnown() || !rawConfig.GetAttr("protocol_mapper").IsKnown() || !rawConfig.GetAttr("config").IsWhollyKnown() {
+		return nil
+	}
+
+	config := make(map[string]string)
+	for key, value := range d.Get("config").(map[string]interface{}) {
+		config[key] = value.(string)
+	}
+
+	_, err := keycloakClient.ValidateProtocolMapperConfig(ctx, d.Get("protocol").(string), d.Get("protocol_mapper").(string), config)
+
+	return err
+}
+
+func getGenericProtocolMapperConfigWarnings(warnings []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for _, warning := range warnings {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  warning,
+			Detail:   "Keycloak stores the value, but the protocol mapper may ignore it.",
+		})
+	}
+
+	return diags
+}
+
+// normalizeGenericProtocolMapperConfig removes the config values that keycloak filled in with the mapper's defaults, so
+// mappers that are configured with a partial config don't show a diff. the full config is kept on import, when there
+// is no configuration to compare with.
+func normalizeGenericProtocolMapperConfig(ctx context.Context, keycloakClient *keycloak.KeycloakClient, data *schema.ResourceData, mapper *keycloak.GenericProtocolMapper) {
+	configured := data.Get("config").(map[string]interface{})
+	if len(configured) == 0 {
+		return
+	}
+
+	unconfigured := false
+	for key := range mapper.Config {
+		if _, ok := configured[key]; !ok {
+			unconfigured = true
+			break
+		}
+	}
+
+	// only the values keycloak filled in need the mapper type
+	if !unconfigured {
+		return
+	}
+
+	mapperType, err := keycloakClient.GetProtocolMapperType(ctx, mapper.Protocol, mapper.ProtocolMapper)
+	if err != nil || mapperType == nil {
+		// the mapper type may have been removed from the server, in which case the config is kept as is
+		return
+	}
+
+	defaults := mapperType.DefaultConfig()
+	for key, value := range mapper.Config {
+		if _, ok := configured[key]; ok {
+			continue
+		}
+
+		if defaultValue, ok := defaults[key]; ok && defaultValue == value {
+			delete(mapper.Config, key)
+		}
+	}
+}
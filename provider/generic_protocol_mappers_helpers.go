@@ -0,0 +1,273 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/charlesderek/terraform-w-keycloak/keycloak"
+)
+
+// the protocol mappers of clients and client scopes are managed the same way, parentAttribute is either client_id or
+// client_scope_id
+func genericProtocolMappersSchema(parentAttribute, parentDescription string) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"realm_id": {
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		parentAttribute: {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: parentDescription,
+		},
+		"mapper": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Description: "The complete list of protocol mappers. Protocol mappers that aren't listed are removed.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:        schema.TypeString,
+						Required:    true,
+						Description: "A human-friendly name that will appear in the Keycloak console.",
+					},
+					"protocol_mapper": {
+						Type:        schema.TypeString,
+						Required:    true,
+						Description: "The type of the protocol mapper, such as oidc-usermodel-attribute-mapper.",
+					},
+					"config": {
+						Type:     schema.TypeMap,
+						Optional: true,
+						Elem:     &schema.Schema{Type: schema.TypeString},
+					},
+				},
+			},
+		},
+	}
+}
+
+func getProtocolMappersTarget(data *schema.ResourceData, parentAttribute string) (string, string, string) {
+	realmId := data.Get("realm_id").(string)
+	parentId := data.Get(parentAttribute).(string)
+
+	if parentAttribute == "client_id" {
+		return realmId, parentId, ""
+	}
+
+	return realmId, "", parentId
+}
+
+// getProtocolMappersParentProtocol returns the protocol of the client or client scope, which its mappers need to use
+func getProtocolMappersParentProtocol(ctx context.Context, keycloakClient *keycloak.KeycloakClient, realmId, clientId, clientScopeId string) (string, error) {
+	if clientId != "" {
+		client, err := keycloakClient.GetGenericClient(ctx, realmId, clientId)
+		if err != nil {
+			return "", err
+		}
+
+		return client.Protocol, nil
+	}
+
+	// the protocol is overwritten when a client scope is updated, but it's returned as is
+	clientScope, err := keycloakClient.GetOpenidClientScope(ctx, realmId, clientScopeId)
+	if err != nil {
+		return "", err
+	}
+
+	return clientScope.Protocol, nil
+}
+
+func getGenericProtocolMappersFromData(data *schema.ResourceData, realmId, clientId, clientScopeId, protocol string) ([]*keycloak.GenericProtocolMapper, error) {
+	var mappers []*keycloak.GenericProtocolMapper
+	names := make(map[string]bool)
+
+	for _, m := range data.Get("mapper").(*schema.Set).List() {
+		mapper := m.(map[string]interface{})
+
+		name := mapper["name"].(string)
+		if names[name] {
+			return nil, fmt.Errorf("validation error: protocol mapper name %s is used more than once", name)
+		}
+		names[name] = true
+
+		config := make(map[string]string)
+		for key, value := range mapper["config"].(map[string]interface{}) {
+			config[key] = value.(string)
+		}
+
+		mappers = append(mappers, &keycloak.GenericProtocolMapper{
+			ClientId:       clientId,
+			ClientScopeId:  clientScopeId,
+			Config:         config,
+			Name:           name,
+			Protocol:       protocol,
+			ProtocolMapper: mapper["protocol_mapper"].(string),
+			RealmId:        realmId,
+		})
+	}
+
+	return mappers, nil
+}
+
+func resourceKeycloakGenericProtocolMappersReconcile(parentAttribute string) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return func(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		keycloakClient := meta.(*keycloak.KeycloakClient)
+
+		realmId, clientId, clientScopeId := getProtocolMappersTarget(data, parentAttribute)
+
+		protocol, err := getProtocolMappersParentProtocol(ctx, keycloakClient, realmId, clientId, clientScopeId)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		tfMappers, err := getGenericProtocolMappersFromData(data, realmId, clientId, clientScopeId, protocol)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		keycloakMappers, err := keycloakClient.ListGenericProtocolMappers(ctx, realmId, clientId, clientScopeId)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		tfMappersByName := make(map[string]*keycloak.GenericProtocolMapper)
+		for _, tfMapper := range tfMappers {
+			tfMappersByName[tfMapper.Name] = tfMapper
+		}
+
+		// mappers that aren't configured are removed first, as well as the ones whose type changed, so that their names
+		// can be reused
+		keycloakMappersByName := make(map[string]*keycloak.GenericProtocolMapper)
+		for _, keycloakMapper := range keycloakMappers {
+			if tfMapper, ok := tfMappersByName[keycloakMapper.Name]; ok && tfMapper.ProtocolMapper == keycloakMapper.ProtocolMapper {
+				keycloakMappersByName[keycloakMapper.Name] = keycloakMapper
+				continue
+			}
+
+			err = keycloakClient.DeleteGenericProtocolMapper(ctx, realmId, clientId, clientScopeId, keycloakMapper.Id)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
+
+		for _, tfMapper := range tfMappers {
+			keycloakMapper, ok := keycloakMappersByName[tfMapper.Name]
+			if !ok {
+				err = keycloakClient.NewGenericProtocolMapper(ctx, tfMapper)
+				if err != nil {
+					return diag.FromErr(err)
+				}
+
+				continue
+			}
+
+			if reflect.DeepEqual(tfMapper.Config, keycloakMapper.Config) {
+				continue
+			}
+
+			tfMapper.Id = keycloakMapper.Id
+
+			err = keycloakClient.UpdateGenericProtocolMapper(ctx, tfMapper)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
+
+		data.SetId(fmt.Sprintf("%s/%s", realmId, data.Get(parentAttribute).(string)))
+
+		return resourceKeycloakGenericProtocolMappersRead(parentAttribute)(ctx, data, meta)
+	}
+}
+
+func resourceKeycloakGenericProtocolMappersRead(parentAttribute string) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return func(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		keycloakClient := meta.(*keycloak.KeycloakClient)
+
+		realmId, clientId, clientScopeId := getProtocolMappersTarget(data, parentAttribute)
+
+		keycloakMappers, err := keycloakClient.ListGenericProtocolMappers(ctx, realmId, clientId, clientScopeId)
+		if err != nil {
+			return handleNotFoundError(ctx, err, data)
+		}
+
+		var mappers []interface{}
+		for _, keycloakMapper := range keycloakMappers {
+			mappers = append(mappers, map[string]interface{}{
+				"name":            keycloakMapper.Name,
+				"protocol_mapper": keycloakMapper.ProtocolMapper,
+				"config":          keycloakMapper.Config,
+			})
+		}
+
+		data.Set("mapper", mappers)
+
+		return nil
+	}
+}
+
+func resourceKeycloakGenericProtocolMappersDelete(parentAttribute string) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return func(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		keycloakClient := meta.(*keycloak.KeycloakClient)
+
+		realmId, clientId, clientScopeId := getProtocolMappersTarget(data, parentAttribute)
+
+		keycloakMappers, err := keycloakClient.ListGenericProtocolMappers(ctx, realmId, clientId, clientScopeId)
+		if err != nil {
+			if keycloak.ErrorIs404(err) {
+				return nil
+			}
+
+			return diag.FromErr(err)
+		}
+
+		names := make(map[string]bool)
+		for _, m := range data.Get("mapper").(*schema.Set).List() {
+			names[m.(map[string]interface{})["name"].(string)] = true
+		}
+
+		// only the mappers managed by this resource are removed
+		for _, keycloakMapper := range keycloakMappers {
+			if !names[keycloakMapper.Name] {
+				continue
+			}
+
+			err = keycloakClient.DeleteGenericProtocolMapper(ctx, realmId, clientId, clientScopeId, keycloakMapper.Id)
+			if err != nil && !keycloak.ErrorIs404(err) {
+				return diag.FromErr(err)
+			}
+		}
+
+		return nil
+	}
+}
+
+func resourceKeycloakGenericProtocolMappersImport(parentAttribute, importFormat string) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+		parts := strings.Split(d.Id(), "/")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("Invalid import. Supported import formats: %s", importFormat)
+		}
+
+		d.Set("realm_id", parts[0])
+		d.Set(parentAttribute, parts[1])
+
+		diagnostics := resourceKeycloakGenericProtocolMappersRead(parentAttribute)(ctx, d, meta)
+		if diagnostics.HasError() {
+			return nil, errors.New(diagnostics[0].Summary)
+		}
+
+		if d.Id() == "" {
+			return nil, fmt.Errorf("%s %s does not exist in realm %s", parentAttribute, parts[1], parts[0])
+		}
+
+		return []*schema.ResourceData{d}, nil
+	}
+}
@@ -0,0 +1,212 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/charlesderek/terraform-w-keycloak/keycloak"
+)
+
+// the role scope mappings of clients and client scopes are managed the same way, parentAttribute is either client_id or
+// client_scope_id
+func genericRoleScopeMappingsSchema(parentAttribute, parentDescription string) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"realm_id": {
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		parentAttribute: {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: parentDescription,
+		},
+		"role_ids": {
+			Type:        schema.TypeSet,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Set:         schema.HashString,
+			Required:    true,
+			Description: "Ids of the realm and client roles that are mapped into the scope. Roles that aren't listed are removed.",
+		},
+	}
+}
+
+func getRoleScopeMappingsTarget(data *schema.ResourceData, parentAttribute string) (string, string, string) {
+	realmId := data.Get("realm_id").(string)
+	parentId := data.Get(parentAttribute).(string)
+
+	if parentAttribute == "client_id" {
+		return realmId, parentId, ""
+	}
+
+	return realmId, "", parentId
+}
+
+func getRoleScopeMappingsParent(ctx context.Context, keycloakClient *keycloak.KeycloakClient, realmId, clientId, clientScopeId string) error {
+	if clientId != "" {
+		_, err := keycloakClient.GetGenericClient(ctx, realmId, clientId)
+		return err
+	}
+
+	// the protocol isn't relevant here, so this works for saml client scopes as well
+	_, err := keycloakClient.GetOpenidClientScope(ctx, realmId, clientScopeId)
+	return err
+}
+
+func addRoleScopeMappings(ctx context.Context, keycloakClient *keycloak.KeycloakClient, realmId, clientId, clientScopeId string, clientRolesToAdd map[string][]*keycloak.Role, realmRolesToAdd []*keycloak.Role) error {
+	if len(realmRolesToAdd) != 0 {
+		err := keycloakClient.AddRealmRoleScopeMappings(ctx, realmId, clientId, clientScopeId, realmRolesToAdd)
+		if err != nil {
+			return err
+		}
+	}
+
+	for roleClientId, roles := range clientRolesToAdd {
+		if len(roles) != 0 {
+			err := keycloakClient.AddClientRoleScopeMappings(ctx, realmId, clientId, clientScopeId, roleClientId, roles)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func removeRoleScopeMappings(ctx context.Context, keycloakClient *keycloak.KeycloakClient, realmId, clientId, clientScopeId string, clientRolesToRemove map[string][]*keycloak.Role, realmRolesToRemove []*keycloak.Role) error {
+	if len(realmRolesToRemove) != 0 {
+		err := keycloakClient.RemoveRealmRoleScopeMappings(ctx, realmId, clientId, clientScopeId, realmRolesToRemove)
+		if err != nil {
+			return err
+		}
+	}
+
+	for roleClientId, roles := range clientRolesToRemove {
+		if len(roles) != 0 {
+			err := keycloakClient.RemoveClientRoleScopeMappings(ctx, realmId, clientId, clientScopeId, roleClientId, roles)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceKeycloakGenericRoleScopeMappingsReconcile(parentAttribute string) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return func(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		keycloakClient := meta.(*keycloak.KeycloakClient)
+
+		realmId, clientId, clientScopeId := getRoleScopeMappingsTarget(data, parentAttribute)
+		roleIds := interfaceSliceToStringSlice(data.Get("role_ids").(*schema.Set).List())
+
+		tfRoles, err := getExtendedRoleMapping(ctx, keycloakClient, realmId, roleIds)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		roleMappings, err := keycloakClient.GetRoleScopeMappings(ctx, realmId, clientId, clientScopeId)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		// every mapped role that isn't in the configuration is removed, including the ones added outside of terraform
+		updates := calculateRoleMappingUpdates(tfRoles, intoRoleMapping(roleMappings))
+
+		err = addRoleScopeMappings(ctx, keycloakClient, realmId, clientId, clientScopeId, updates.clientRolesToAdd, updates.realmRolesToAdd)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		err = removeRoleScopeMappings(ctx, keycloakClient, realmId, clientId, clientScopeId, updates.clientRolesToRemove, updates.realmRolesToRemove)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		data.SetId(fmt.Sprintf("%s/%s", realmId, data.Get(parentAttribute).(string)))
+
+		return resourceKeycloakGenericRoleScopeMappingsRead(parentAttribute)(ctx, data, meta)
+	}
+}
+
+func resourceKeycloakGenericRoleScopeMappingsRead(parentAttribute string) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return func(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		keycloakClient := meta.(*keycloak.KeycloakClient)
+
+		realmId, clientId, clientScopeId := getRoleScopeMappingsTarget(data, parentAttribute)
+
+		if err := getRoleScopeMappingsParent(ctx, keycloakClient, realmId, clientId, clientScopeId); err != nil {
+			return handleNotFoundError(ctx, err, data)
+		}
+
+		roleMappings, err := keycloakClient.GetRoleScopeMappings(ctx, realmId, clientId, clientScopeId)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		var roleIds []string
+
+		for _, realmRole := range roleMappings.RealmMappings {
+			roleIds = append(roleIds, realmRole.Id)
+		}
+
+		for _, clientRoleMapping := range roleMappings.ClientMappings {
+			for _, clientRole := range clientRoleMapping.Mappings {
+				roleIds = append(roleIds, clientRole.Id)
+			}
+		}
+
+		data.Set("role_ids", roleIds)
+
+		return nil
+	}
+}
+
+func resourceKeycloakGenericRoleScopeMappingsDelete(parentAttribute string) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return func(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		keycloakClient := meta.(*keycloak.KeycloakClient)
+
+		realmId, clientId, clientScopeId := getRoleScopeMappingsTarget(data, parentAttribute)
+		roleIds := interfaceSliceToStringSlice(data.Get("role_ids").(*schema.Set).List())
+
+		rolesToRemove, err := getExtendedRoleMapping(ctx, keycloakClient, realmId, roleIds)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		err = removeRoleScopeMappings(ctx, keycloakClient, realmId, clientId, clientScopeId, rolesToRemove.clientRoles, rolesToRemove.realmRoles)
+		if err != nil && !keycloak.ErrorIs404(err) {
+			return diag.FromErr(err)
+		}
+
+		return nil
+	}
+}
+
+func resourceKeycloakGenericRoleScopeMappingsImport(parentAttribute, importFormat string) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+		parts := strings.Split(d.Id(), "/")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("Invalid import. Supported import formats: %s", importFormat)
+		}
+
+		d.Set("realm_id", parts[0])
+		d.Set(parentAttribute, parts[1])
+
+		diagnostics := resourceKeycloakGenericRoleScopeMappingsRead(parentAttribute)(ctx, d, meta)
+		if diagnostics.HasError() {
+			return nil, errors.New(diagnostics[0].Summary)
+		}
+
+		if d.Id() == "" {
+			return nil, fmt.Errorf("%s %s does not exist in realm %s", parentAttribute, parts[1], parts[0])
+		}
+
+		return []*schema.ResourceData{d}, nil
+	}
+}
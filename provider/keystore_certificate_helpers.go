@@ -0,0 +1,272 @@
+package provider
+
+import (
+	"context"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/charlesderek/terraform-w-keycloak/keycloak"
+)
+
+const realmKeystoreMinimumRsaKeySize = 1024
+
+// the computed attributes describing the certificate of an imported key, along with the setting for the expiry warning
+func realmKeystoreCertificateSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"certificate_expiry_warning_days": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      30,
+			ValidateFunc: validation.IntAtLeast(0),
+			Description:  "Emit a warning when the certificate expires within this many days. Set to 0 to disable the warning.",
+		},
+		"certificate_not_after": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"certificate_subject": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"certificate_fingerprint_sha256": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+// decodeRealmKeystorePem accepts PEM blocks as well as the bare base64 encoded DER that keycloak returns and that is
+// commonly used for these attributes
+func decodeRealmKeystorePem(value string) ([]byte, error) {
+	if block, _ := pem.Decode([]byte(strings.TrimSpace(value))); block != nil {
+		return block.Bytes, nil
+	}
+
+	stripped := strings.Join(strings.Fields(value), "")
+
+	return base64.StdEncoding.DecodeString(stripped)
+}
+
+func parseRealmKeystoreCertificate(value string) (*x509.Certificate, error) {
+	der, err := decodeRealmKeystorePem(value)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode certificate: %s", err)
+	}
+
+	certificate, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse certificate: %s", err)
+	}
+
+	return certificate, nil
+}
+
+func parseRealmKeystoreRsaPrivateKey(value string) (*rsa.PrivateKey, error) {
+	der, err := decodeRealmKeystorePem(value)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode private key: %s", err)
+	}
+
+	if privateKey, err := x509.ParsePKCS1PrivateKey(der); err == nil {
+		return privateKey, nil
+	}
+
+	privateKey, err := x509.ParsePKCS8PrivateKey(der)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse private key, expected a PKCS#1 or PKCS#8 encoded key: %s", err)
+	}
+
+	rsaPrivateKey, ok := privateKey.(*rsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("private key is a %T, expected an RSA key", privateKey)
+	}
+
+	return rsaPrivateKey, nil
+}
+
+// the hash size of the RS*, PS* and RSA-OAEP-256 algorithms, used to check that the key is long enough to be used with
+// PSS and OAEP padding
+func realmKeystoreAlgorithmHashSize(algorithm string) int {
+	switch {
+	case strings.HasSuffix(algorithm, "256"):
+		return sha256.Size
+	case strings.HasSuffix(algorithm, "384"):
+		return 48
+	case strings.HasSuffix(algorithm, "512"):
+		return 64
+	case algorithm == "RSA-OAEP":
+		return 20 // sha1
+	}
+
+	return 0
+}
+
+// validateRealmKeystoreRsaKeyPair checks that the private key and certificate of an imported rsa key belong together,
+// and that the key can be used with the configured algorithm
+func validateRealmKeystoreRsaKeyPair(privateKeyValue, certificateValue, algorithm string) error {
+	privateKey, err := parseRealmKeystoreRsaPrivateKey(privateKeyValue)
+	if err != nil {
+		return fmt.Errorf("validation error: %s", err)
+	}
+
+	certificate, err := parseRealmKeystoreCertificate(certificateValue)
+	if err != nil {
+		return fmt.Errorf("validation error: %s", err)
+	}
+
+	publicKey, ok := certificate.PublicKey.(*rsa.PublicKey)
+	if !ok {
+		return fmt.Errorf("validation error: certificate contains a %T, expected an RSA public key", certificate.PublicKey)
+	}
+
+	if publicKey.N.Cmp(privateKey.N) != 0 || publicKey.E != privateKey.E {
+		return fmt.Errorf("validation error: private key does not match the public key of the certificate")
+	}
+
+	keySize := privateKey.N.BitLen()
+	if keySize < realmKeystoreMinimumRsaKeySize {
+		return fmt.Errorf("validation error: private key is %d bits, keys must be at least %d bits", keySize, realmKeystoreMinimumRsaKeySize)
+	}
+
+	// PSS and OAEP padding need room for two hashes, which rules out small keys for the larger hashes
+	if strings.HasPrefix(algorithm, "PS") || strings.HasPrefix(algorithm, "RSA-OAEP") {
+		hashSize := realmKeystoreAlgorithmHashSize(algorithm)
+		if privateKey.Size() < 2*hashSize+2 {
+			return fmt.Errorf("validation error: a %d bit key is too small to be used with %s", keySize, algorithm)
+		}
+	}
+
+	return nil
+}
+
+// validateRealmKeystoreRsaKeyPairCustomizeDiff validates the key material of an imported rsa key at plan time, and
+// plans the computed certificate attributes when the certificate changes
+func validateRealmKeystoreRsaKeyPairCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	rawConfig := d.GetRawConfig()
+	if rawConfig.IsNull() {
+		return nil
+	}
+
+	for _, attribute := range []string{"private_key", "certificate", "algorithm"} {
+		if !rawConfig.GetAttr(attribute).IsKnown() {
+			return nil
+		}
+	}
+
+	err := validateRealmKeystoreRsaKeyPair(d.Get("private_key").(string), d.Get("certificate").(string), d.Get("algorithm").(string))
+	if err != nil {
+		return err
+	}
+
+	if !d.HasChange("certificate") {
+		return nil
+	}
+
+	certificate, err := parseRealmKeystoreCertificate(d.Get("certificate").(string))
+	if err != nil {
+		return err
+	}
+
+	for attribute, value := range getRealmKeystoreCertificateAttributes(certificate) {
+		err := d.SetNew(attribute, value)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func getRealmKeystoreCertificateFingerprint(certificate *x509.Certificate) string {
+	fingerprint := sha256.Sum256(certificate.Raw)
+
+	return hex.EncodeToString(fingerprint[:])
+}
+
+func getRealmKeystoreCertificateAttributes(certificate *x509.Certificate) map[string]string {
+	return map[string]string{
+		"certificate_not_after":          certificate.NotAfter.UTC().Format(time.RFC3339),
+		"certificate_subject":            certificate.Subject.String(),
+		"certificate_fingerprint_sha256": getRealmKeystoreCertificateFingerprint(certificate),
+	}
+}
+
+// getRealmKeystoreCertificateParseWarning is used for certificates that keycloak accepts, but go can't parse, such as
+// certificates of Ed448 keys. the certificate attributes are left empty rather than failing.
+func getRealmKeystoreCertificateParseWarning(kid string, err error) diag.Diagnostic {
+	return diag.Diagnostic{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("Could not parse certificate of key %s", kid),
+		Detail:   fmt.Sprintf("The certificate attributes of this key are left empty: %s", err),
+	}
+}
+
+// setRealmKeystoreCertificateData sets the computed certificate attributes from the certificate keycloak reports for the
+// keystore. this works for every imported key, including java keystores whose files can only be read by the server.
+// a warning is returned if the certificate expires soon.
+func setRealmKeystoreCertificateData(ctx context.Context, keycloakClient *keycloak.KeycloakClient, data *schema.ResourceData) diag.Diagnostics {
+	keys, err := keycloakClient.GetRealmKeys(ctx, data.Get("realm_id").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var certificate *x509.Certificate
+	for _, key := range keys.Keys {
+		if StringValue(key.ProviderId) != data.Id() || StringValue(key.Certificate) == "" {
+			continue
+		}
+
+		certificate, err = parseRealmKeystoreCertificate(StringValue(key.Certificate))
+		if err != nil {
+			for attribute := range getRealmKeystoreCertificateAttributes(&x509.Certificate{}) {
+				data.Set(attribute, "")
+			}
+
+			return diag.Diagnostics{getRealmKeystoreCertificateParseWarning(StringValue(key.Kid), err)}
+		}
+
+		break
+	}
+
+	if certificate == nil {
+		return nil
+	}
+
+	for attribute, value := range getRealmKeystoreCertificateAttributes(certificate) {
+		data.Set(attribute, value)
+	}
+
+	return getRealmKeystoreCertificateExpiryDiagnostics(certificate, data.Get("certificate_expiry_warning_days").(int))
+}
+
+func getRealmKeystoreCertificateExpiryDiagnostics(certificate *x509.Certificate, warningDays int) diag.Diagnostics {
+	if warningDays == 0 {
+		return nil
+	}
+
+	remaining := time.Until(certificate.NotAfter)
+	if remaining > time.Duration(warningDays)*24*time.Hour {
+		return nil
+	}
+
+	summary := fmt.Sprintf("Certificate %s expires in %d days", certificate.Subject.String(), int(remaining.Hours()/24))
+	if remaining <= 0 {
+		summary = fmt.Sprintf("Certificate %s has expired", certificate.Subject.String())
+	}
+
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  summary,
+		Detail:   fmt.Sprintf("The certificate is valid until %s. Replace the key before it expires.", certificate.NotAfter.UTC().Format(time.RFC3339)),
+	}}
+}
@@ -0,0 +1,140 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/charlesderek/terraform-w-keycloak/keycloak"
+)
+
+// the openid client attributes that could only be set through extra_config before they were added to the schema. these
+// keys are still accepted in extra_config when the attribute itself isn't set, with a deprecation warning.
+var openidClientLegacyExtraConfigAttributes = map[string]string{
+	"use.jwks.url":                    "use_jwks_url",
+	"jwks.url":                        "jwks_url",
+	"token.endpoint.auth.signing.alg": "token_endpoint_auth_signing_alg",
+	"tls.client.certificate.bound.access.tokens": "tls_client_certificate_bound_access_tokens",
+	"x509.subjectdn":                                          "x509_subject_dn",
+	"x509.allow.regex.pattern.comparison":                     "x509_allow_regex_pattern_comparison",
+	"require.pushed.authorization.requests":                   "require_pushed_authorization_requests",
+	"oidc.ciba.grant.enabled":                                 "ciba_grant_enabled",
+	"ciba.backchannel.token.delivery.mode":                    "ciba_backchannel_token_delivery_mode",
+	"ciba.backchannel.client.notification.endpoint":           "ciba_backchannel_client_notification_endpoint",
+	"dpop.bound.access.tokens":                                "dpop_bound_access_tokens",
+	"id.token.signed.response.alg":                            "id_token_signed_response_alg",
+	"id.token.encrypted.response.alg":                         "id_token_encrypted_response_alg",
+	"id.token.encrypted.response.enc":                         "id_token_encrypted_response_enc",
+	"user.info.response.signature.alg":                        "user_info_response_signature_alg",
+	"user.info.encrypted.response.alg":                        "user_info_encrypted_response_alg",
+	"user.info.encrypted.response.enc":                        "user_info_encrypted_response_enc",
+	"authorization.signed.response.alg":                       "authorization_signed_response_alg",
+	"authorization.encrypted.response.alg":                    "authorization_encrypted_response_alg",
+	"authorization.encrypted.response.enc":                    "authorization_encrypted_response_enc",
+	"acr.loa.map":                                             "acr_loa_map",
+	"default.acr.values":                                      "default_acr_values",
+	"standard.token.exchange.enabled":                         "standard_token_exchange_enabled",
+	"standard.token.exchange.enableRefreshRequestedTokenType": "standard_token_exchange_refresh_token_type",
+}
+
+// applyOpenidClientLegacyExtraConfig copies the legacy extra_config keys into the attributes they belong to, unless the
+// attribute is configured, in which case it takes precedence
+func applyOpenidClientLegacyExtraConfig(data *schema.ResourceData, attributes *keycloak.OpenidClientAttributes) error {
+	rawConfig := data.GetRawConfig()
+	if rawConfig.IsNull() {
+		return nil
+	}
+
+	legacyConfig := map[string]interface{}{}
+	for key, attribute := range openidClientLegacyExtraConfigAttributes {
+		if value, ok := attributes.ExtraConfig[key]; ok && rawConfig.GetAttr(attribute).IsNull() {
+			legacyConfig[key] = value
+		}
+	}
+
+	if len(legacyConfig) == 0 {
+		return nil
+	}
+
+	// the attributes are round tripped through their json representation, which parses the values the same way as when
+	// they're read from keycloak
+	config, err := getOpenidClientAttributesConfig(attributes)
+	if err != nil {
+		return err
+	}
+
+	for key, value := range legacyConfig {
+		config[key] = value
+	}
+
+	body, err := json.Marshal(config)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(body, attributes)
+}
+
+// setOpenidClientLegacyExtraConfig keeps the legacy extra_config keys that are still in use in extra_config, rather than
+// in the attributes they belong to, so they don't show a diff. this has to happen before the attributes are set.
+func setOpenidClientLegacyExtraConfig(data *schema.ResourceData, attributes *keycloak.OpenidClientAttributes) ([]string, error) {
+	extraConfig := getExtraConfigFromData(data)
+
+	legacyKeys := map[string]string{}
+	for key, attribute := range openidClientLegacyExtraConfigAttributes {
+		if _, ok := extraConfig[key]; ok && isZeroAttributeValue(data.Get(attribute)) {
+			legacyKeys[key] = attribute
+		}
+	}
+
+	if len(legacyKeys) == 0 {
+		return nil, nil
+	}
+
+	config, err := getOpenidClientAttributesConfig(attributes)
+	if err != nil {
+		return nil, err
+	}
+
+	if attributes.ExtraConfig == nil {
+		attributes.ExtraConfig = map[string]interface{}{}
+	}
+
+	legacyAttributes := make([]string, 0, len(legacyKeys))
+	for key, attribute := range legacyKeys {
+		attributes.ExtraConfig[key] = config[key]
+		legacyAttributes = append(legacyAttributes, attribute)
+	}
+
+	return legacyAttributes, nil
+}
+
+func getOpenidClientAttributesConfig(attributes *keycloak.OpenidClientAttributes) (map[string]interface{}, error) {
+	body, err := json.Marshal(attributes)
+	if err != nil {
+		return nil, err
+	}
+
+	var config map[string]interface{}
+	err = json.Unmarshal(body, &config)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read client attributes: %w", err)
+	}
+
+	return config, nil
+}
+
+func isZeroAttributeValue(value interface{}) bool {
+	switch v := value.(type) {
+	case string:
+		return v == ""
+	case bool:
+		return !v
+	case []interface{}:
+		return len(v) == 0
+	case map[string]interface{}:
+		return len(v) == 0
+	}
+
+	return value == nil
+}
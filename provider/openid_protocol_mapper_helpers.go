@@ -0,0 +1,18 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// getAddToTokenIntrospectionFromData returns the configured add_to_token_introspection value of an openid protocol
+// mapper. keycloak treats mappers without this setting as if it matched add_to_access_token, so the same is done when it
+// isn't configured, which keeps claims that are left out of the access token out of the introspection response as well
+func getAddToTokenIntrospectionFromData(data *schema.ResourceData) bool {
+	if rawConfig := data.GetRawConfig(); !rawConfig.IsNull() {
+		if addToTokenIntrospection := rawConfig.GetAttr("add_to_token_introspection"); !addToTokenIntrospection.IsNull() {
+			return addToTokenIntrospection.True()
+		}
+	}
+
+	return data.Get("add_to_access_token").(bool)
+}
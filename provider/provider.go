@@ -13,109 +13,158 @@ import (
 func KeycloakProvider(client *keycloak.KeycloakClient) *schema.Provider {
 	provider := &schema.Provider{
 		DataSourcesMap: map[string]*schema.Resource{
-			"keycloak_group":                              dataSourceKeycloakGroup(),
-			"keycloak_openid_client":                      dataSourceKeycloakOpenidClient(),
-			"keycloak_openid_client_authorization_policy": dataSourceKeycloakOpenidClientAuthorizationPolicy(),
-			"keycloak_openid_client_scope":                dataSourceKeycloakOpenidClientScope(),
-			"keycloak_openid_client_service_account_user": dataSourceKeycloakOpenidClientServiceAccountUser(),
-			"keycloak_realm":                              dataSourceKeycloakRealm(),
-			"keycloak_realm_keys":                         dataSourceKeycloakRealmKeys(),
-			"keycloak_role":                               dataSourceKeycloakRole(),
-			"keycloak_user":                               dataSourceKeycloakUser(),
-			"keycloak_user_realm_roles":                   dataSourceKeycloakUserRealmRoles(),
-			"keycloak_saml_client_installation_provider":  dataSourceKeycloakSamlClientInstallationProvider(),
-			"keycloak_saml_client":                        dataSourceKeycloakSamlClient(),
-			"keycloak_authentication_execution":           dataSourceKeycloakAuthenticationExecution(),
-			"keycloak_authentication_flow":                dataSourceKeycloakAuthenticationFlow(),
-			"keycloak_client_description_converter":       dataSourceKeycloakClientDescriptionConverter(),
+			"keycloak_group":                               dataSourceKeycloakGroup(),
+			"keycloak_identity_provider":                   dataSourceKeycloakIdentityProvider(),
+			"keycloak_identity_providers":                  dataSourceKeycloakIdentityProviders(),
+			"keycloak_openid_client":                       dataSourceKeycloakOpenidClient(),
+			"keycloak_openid_client_authorization_policy":  dataSourceKeycloakOpenidClientAuthorizationPolicy(),
+			"keycloak_openid_client_installation_provider": dataSourceKeycloakOpenidClientInstallationProvider(),
+			"keycloak_openid_client_scope":                 dataSourceKeycloakOpenidClientScope(),
+			"keycloak_openid_client_secret":                dataSourceKeycloakOpenidClientSecret(),
+			"keycloak_openid_client_service_account_user":  dataSourceKeycloakOpenidClientServiceAccountUser(),
+			"keycloak_openid_token":                        dataSourceKeycloakOpenidToken(),
+			"keycloak_realm":                               dataSourceKeycloakRealm(),
+			"keycloak_realm_jwks":                          dataSourceKeycloakRealmJwks(),
+			"keycloak_realm_keys":                          dataSourceKeycloakRealmKeys(),
+			"keycloak_realm_openid_configuration":          dataSourceKeycloakRealmOpenidConfiguration(),
+			"keycloak_role":                                dataSourceKeycloakRole(),
+			"keycloak_user":                                dataSourceKeycloakUser(),
+			"keycloak_user_realm_roles":                    dataSourceKeycloakUserRealmRoles(),
+			"keycloak_saml_client_installation_provider":   dataSourceKeycloakSamlClientInstallationProvider(),
+			"keycloak_saml_client":                         dataSourceKeycloakSamlClient(),
+			"keycloak_authentication_execution":            dataSourceKeycloakAuthenticationExecution(),
+			"keycloak_authentication_flow":                 dataSourceKeycloakAuthenticationFlow(),
+			"keycloak_client_description_converter":        dataSourceKeycloakClientDescriptionConverter(),
+			"keycloak_identity_provider_import_config":     dataSourceKeycloakIdentityProviderImportConfig(),
 		},
 		ResourcesMap: map[string]*schema.Resource{
-			"keycloak_realm":                                             resourceKeycloakRealm(),
-			"keycloak_realm_events":                                      resourceKeycloakRealmEvents(),
-			"keycloak_realm_keystore_aes_generated":                      resourceKeycloakRealmKeystoreAesGenerated(),
-			"keycloak_realm_keystore_ecdsa_generated":                    resourceKeycloakRealmKeystoreEcdsaGenerated(),
-			"keycloak_realm_keystore_hmac_generated":                     resourceKeycloakRealmKeystoreHmacGenerated(),
-			"keycloak_realm_keystore_java_keystore":                      resourceKeycloakRealmKeystoreJavaKeystore(),
-			"keycloak_realm_keystore_rsa":                                resourceKeycloakRealmKeystoreRsa(),
-			"keycloak_realm_keystore_rsa_generated":                      resourceKeycloakRealmKeystoreRsaGenerated(),
-			"keycloak_realm_user_profile":                                resourceKeycloakRealmUserProfile(),
-			"keycloak_required_action":                                   resourceKeycloakRequiredAction(),
-			"keycloak_group":                                             resourceKeycloakGroup(),
-			"keycloak_group_memberships":                                 resourceKeycloakGroupMemberships(),
-			"keycloak_default_groups":                                    resourceKeycloakDefaultGroups(),
-			"keycloak_default_roles":                                     resourceKeycloakDefaultRoles(),
-			"keycloak_group_roles":                                       resourceKeycloakGroupRoles(),
-			"keycloak_user":                                              resourceKeycloakUser(),
-			"keycloak_user_roles":                                        resourceKeycloakUserRoles(),
-			"keycloak_openid_client":                                     resourceKeycloakOpenidClient(),
-			"keycloak_openid_client_scope":                               resourceKeycloakOpenidClientScope(),
-			"keycloak_ldap_user_federation":                              resourceKeycloakLdapUserFederation(),
-			"keycloak_ldap_user_attribute_mapper":                        resourceKeycloakLdapUserAttributeMapper(),
-			"keycloak_ldap_group_mapper":                                 resourceKeycloakLdapGroupMapper(),
-			"keycloak_ldap_role_mapper":                                  resourceKeycloakLdapRoleMapper(),
-			"keycloak_ldap_hardcoded_role_mapper":                        resourceKeycloakLdapHardcodedRoleMapper(),
-			"keycloak_ldap_hardcoded_attribute_mapper":                   resourceKeycloakLdapHardcodedAttributeMapper(),
-			"keycloak_ldap_hardcoded_group_mapper":                       resourceKeycloakLdapHardcodedGroupMapper(),
-			"keycloak_ldap_msad_user_account_control_mapper":             resourceKeycloakLdapMsadUserAccountControlMapper(),
-			"keycloak_ldap_msad_lds_user_account_control_mapper":         resourceKeycloakLdapMsadLdsUserAccountControlMapper(),
-			"keycloak_ldap_full_name_mapper":                             resourceKeycloakLdapFullNameMapper(),
-			"keycloak_custom_user_federation":                            resourceKeycloakCustomUserFederation(),
-			"keycloak_openid_user_attribute_protocol_mapper":             resourceKeycloakOpenIdUserAttributeProtocolMapper(),
-			"keycloak_openid_user_property_protocol_mapper":              resourceKeycloakOpenIdUserPropertyProtocolMapper(),
-			"keycloak_openid_group_membership_protocol_mapper":           resourceKeycloakOpenIdGroupMembershipProtocolMapper(),
-			"keycloak_openid_full_name_protocol_mapper":                  resourceKeycloakOpenIdFullNameProtocolMapper(),
-			"keycloak_openid_hardcoded_claim_protocol_mapper":            resourceKeycloakOpenIdHardcodedClaimProtocolMapper(),
-			"keycloak_openid_audience_protocol_mapper":                   resourceKeycloakOpenIdAudienceProtocolMapper(),
-			"keycloak_openid_audience_resolve_protocol_mapper":           resourceKeycloakOpenIdAudienceResolveProtocolMapper(),
-			"keycloak_openid_hardcoded_role_protocol_mapper":             resourceKeycloakOpenIdHardcodedRoleProtocolMapper(),
-			"keycloak_openid_user_realm_role_protocol_mapper":            resourceKeycloakOpenIdUserRealmRoleProtocolMapper(),
-			"keycloak_openid_user_client_role_protocol_mapper":           resourceKeycloakOpenIdUserClientRoleProtocolMapper(),
-			"keycloak_openid_user_session_note_protocol_mapper":          resourceKeycloakOpenIdUserSessionNoteProtocolMapper(),
-			"keycloak_openid_script_protocol_mapper":                     resourceKeycloakOpenIdScriptProtocolMapper(),
-			"keycloak_openid_client_default_scopes":                      resourceKeycloakOpenidClientDefaultScopes(),
-			"keycloak_openid_client_optional_scopes":                     resourceKeycloakOpenidClientOptionalScopes(),
-			"keycloak_saml_client":                                       resourceKeycloakSamlClient(),
-			"keycloak_saml_client_scope":                                 resourceKeycloakSamlClientScope(),
-			"keycloak_saml_client_default_scopes":                        resourceKeycloakSamlClientDefaultScopes(),
-			"keycloak_generic_client_protocol_mapper":                    resourceKeycloakGenericClientProtocolMapper(),
-			"keycloak_generic_client_role_mapper":                        resourceKeycloakGenericClientRoleMapper(),
-			"keycloak_generic_protocol_mapper":                           resourceKeycloakGenericProtocolMapper(),
-			"keycloak_generic_role_mapper":                               resourceKeycloakGenericRoleMapper(),
-			"keycloak_saml_user_attribute_protocol_mapper":               resourceKeycloakSamlUserAttributeProtocolMapper(),
-			"keycloak_saml_user_property_protocol_mapper":                resourceKeycloakSamlUserPropertyProtocolMapper(),
-			"keycloak_saml_script_protocol_mapper":                       resourceKeycloakSamlScriptProtocolMapper(),
-			"keycloak_hardcoded_attribute_identity_provider_mapper":      resourceKeycloakHardcodedAttributeIdentityProviderMapper(),
-			"keycloak_hardcoded_role_identity_provider_mapper":           resourceKeycloakHardcodedRoleIdentityProviderMapper(),
-			"keycloak_attribute_importer_identity_provider_mapper":       resourceKeycloakAttributeImporterIdentityProviderMapper(),
-			"keycloak_attribute_to_role_identity_provider_mapper":        resourceKeycloakAttributeToRoleIdentityProviderMapper(),
-			"keycloak_user_template_importer_identity_provider_mapper":   resourceKeycloakUserTemplateImporterIdentityProviderMapper(),
-			"keycloak_custom_identity_provider_mapper":                   resourceKeycloakCustomIdentityProviderMapper(),
-			"keycloak_saml_identity_provider":                            resourceKeycloakSamlIdentityProvider(),
-			"keycloak_oidc_google_identity_provider":                     resourceKeycloakOidcGoogleIdentityProvider(),
-			"keycloak_oidc_identity_provider":                            resourceKeycloakOidcIdentityProvider(),
-			"keycloak_openid_client_authorization_resource":              resourceKeycloakOpenidClientAuthorizationResource(),
-			"keycloak_openid_client_group_policy":                        resourceKeycloakOpenidClientAuthorizationGroupPolicy(),
-			"keycloak_openid_client_role_policy":                         resourceKeycloakOpenidClientAuthorizationRolePolicy(),
-			"keycloak_openid_client_aggregate_policy":                    resourceKeycloakOpenidClientAuthorizationAggregatePolicy(),
-			"keycloak_openid_client_js_policy":                           resourceKeycloakOpenidClientAuthorizationJSPolicy(),
-			"keycloak_openid_client_time_policy":                         resourceKeycloakOpenidClientAuthorizationTimePolicy(),
-			"keycloak_openid_client_user_policy":                         resourceKeycloakOpenidClientAuthorizationUserPolicy(),
-			"keycloak_openid_client_client_policy":                       resourceKeycloakOpenidClientAuthorizationClientPolicy(),
-			"keycloak_openid_client_authorization_scope":                 resourceKeycloakOpenidClientAuthorizationScope(),
-			"keycloak_openid_client_authorization_permission":            resourceKeycloakOpenidClientAuthorizationPermission(),
-			"keycloak_openid_client_service_account_role":                resourceKeycloakOpenidClientServiceAccountRole(),
-			"keycloak_openid_client_service_account_realm_role":          resourceKeycloakOpenidClientServiceAccountRealmRole(),
-			"keycloak_role":                                              resourceKeycloakRole(),
-			"keycloak_authentication_flow":                               resourceKeycloakAuthenticationFlow(),
-			"keycloak_authentication_subflow":                            resourceKeycloakAuthenticationSubFlow(),
-			"keycloak_authentication_execution":                          resourceKeycloakAuthenticationExecution(),
-			"keycloak_authentication_execution_config":                   resourceKeycloakAuthenticationExecutionConfig(),
-			"keycloak_identity_provider_token_exchange_scope_permission": resourceKeycloakIdentityProviderTokenExchangeScopePermission(),
-			"keycloak_openid_client_permissions":                         resourceKeycloakOpenidClientPermissions(),
-			"keycloak_users_permissions":                                 resourceKeycloakUsersPermissions(),
-			"keycloak_user_groups":                                       resourceKeycloakUserGroups(),
-			"keycloak_group_permissions":                                 resourceKeycloakGroupPermissions(),
-			"keycloak_authentication_bindings":                           resourceKeycloakAuthenticationBindings(),
+			"keycloak_realm":                                                resourceKeycloakRealm(),
+			"keycloak_realm_events":                                         resourceKeycloakRealmEvents(),
+			"keycloak_realm_default_client_scopes":                          resourceKeycloakRealmDefaultClientScopes(),
+			"keycloak_realm_optional_client_scopes":                         resourceKeycloakRealmOptionalClientScopes(),
+			"keycloak_realm_keystore_aes_generated":                         resourceKeycloakRealmKeystoreAesGenerated(),
+			"keycloak_realm_keystore_ecdsa_generated":                       resourceKeycloakRealmKeystoreEcdsaGenerated(),
+			"keycloak_realm_keystore_eddsa_generated":                       resourceKeycloakRealmKeystoreEddsaGenerated(),
+			"keycloak_realm_keystore_hmac_generated":                        resourceKeycloakRealmKeystoreHmacGenerated(),
+			"keycloak_realm_keystore_java_keystore":                         resourceKeycloakRealmKeystoreJavaKeystore(),
+			"keycloak_realm_keystore_rsa":                                   resourceKeycloakRealmKeystoreRsa(),
+			"keycloak_realm_keystore_rsa_enc":                               resourceKeycloakRealmKeystoreRsaEnc(),
+			"keycloak_realm_keystore_rsa_enc_generated":                     resourceKeycloakRealmKeystoreRsaEncGenerated(),
+			"keycloak_realm_keystore_rsa_generated":                         resourceKeycloakRealmKeystoreRsaGenerated(),
+			"keycloak_realm_key_rotation":                                   resourceKeycloakRealmKeyRotation(),
+			"keycloak_realm_user_profile":                                   resourceKeycloakRealmUserProfile(),
+			"keycloak_required_action":                                      resourceKeycloakRequiredAction(),
+			"keycloak_group":                                                resourceKeycloakGroup(),
+			"keycloak_group_memberships":                                    resourceKeycloakGroupMemberships(),
+			"keycloak_default_groups":                                       resourceKeycloakDefaultGroups(),
+			"keycloak_default_roles":                                        resourceKeycloakDefaultRoles(),
+			"keycloak_group_roles":                                          resourceKeycloakGroupRoles(),
+			"keycloak_user":                                                 resourceKeycloakUser(),
+			"keycloak_user_roles":                                           resourceKeycloakUserRoles(),
+			"keycloak_openid_client":                                        resourceKeycloakOpenidClient(),
+			"keycloak_openid_client_scope":                                  resourceKeycloakOpenidClientScope(),
+			"keycloak_ldap_user_federation":                                 resourceKeycloakLdapUserFederation(),
+			"keycloak_ldap_user_attribute_mapper":                           resourceKeycloakLdapUserAttributeMapper(),
+			"keycloak_ldap_group_mapper":                                    resourceKeycloakLdapGroupMapper(),
+			"keycloak_ldap_role_mapper":                                     resourceKeycloakLdapRoleMapper(),
+			"keycloak_ldap_hardcoded_role_mapper":                           resourceKeycloakLdapHardcodedRoleMapper(),
+			"keycloak_ldap_hardcoded_attribute_mapper":                      resourceKeycloakLdapHardcodedAttributeMapper(),
+			"keycloak_ldap_hardcoded_group_mapper":                          resourceKeycloakLdapHardcodedGroupMapper(),
+			"keycloak_ldap_msad_user_account_control_mapper":                resourceKeycloakLdapMsadUserAccountControlMapper(),
+			"keycloak_ldap_msad_lds_user_account_control_mapper":            resourceKeycloakLdapMsadLdsUserAccountControlMapper(),
+			"keycloak_ldap_full_name_mapper":                                resourceKeycloakLdapFullNameMapper(),
+			"keycloak_custom_user_federation":                               resourceKeycloakCustomUserFederation(),
+			"keycloak_openid_user_attribute_protocol_mapper":                resourceKeycloakOpenIdUserAttributeProtocolMapper(),
+			"keycloak_openid_user_property_protocol_mapper":                 resourceKeycloakOpenIdUserPropertyProtocolMapper(),
+			"keycloak_openid_group_membership_protocol_mapper":              resourceKeycloakOpenIdGroupMembershipProtocolMapper(),
+			"keycloak_openid_full_name_protocol_mapper":                     resourceKeycloakOpenIdFullNameProtocolMapper(),
+			"keycloak_openid_hardcoded_claim_protocol_mapper":               resourceKeycloakOpenIdHardcodedClaimProtocolMapper(),
+			"keycloak_openid_audience_protocol_mapper":                      resourceKeycloakOpenIdAudienceProtocolMapper(),
+			"keycloak_openid_audience_resolve_protocol_mapper":              resourceKeycloakOpenIdAudienceResolveProtocolMapper(),
+			"keycloak_openid_hardcoded_role_protocol_mapper":                resourceKeycloakOpenIdHardcodedRoleProtocolMapper(),
+			"keycloak_openid_user_realm_role_protocol_mapper":               resourceKeycloakOpenIdUserRealmRoleProtocolMapper(),
+			"keycloak_openid_user_client_role_protocol_mapper":              resourceKeycloakOpenIdUserClientRoleProtocolMapper(),
+			"keycloak_openid_user_session_note_protocol_mapper":             resourceKeycloakOpenIdUserSessionNoteProtocolMapper(),
+			"keycloak_openid_script_protocol_mapper":                        resourceKeycloakOpenIdScriptProtocolMapper(),
+			"keycloak_openid_pairwise_subject_protocol_mapper":              resourceKeycloakOpenIdPairwiseSubjectProtocolMapper(),
+			"keycloak_openid_allowed_origins_protocol_mapper":               resourceKeycloakOpenIdAllowedOriginsProtocolMapper(),
+			"keycloak_openid_acr_protocol_mapper":                           resourceKeycloakOpenIdAcrProtocolMapper(),
+			"keycloak_openid_claims_parameter_protocol_mapper":              resourceKeycloakOpenIdClaimsParameterProtocolMapper(),
+			"keycloak_openid_sub_protocol_mapper":                           resourceKeycloakOpenIdSubProtocolMapper(),
+			"keycloak_openid_client_default_scopes":                         resourceKeycloakOpenidClientDefaultScopes(),
+			"keycloak_openid_client_optional_scopes":                        resourceKeycloakOpenidClientOptionalScopes(),
+			"keycloak_openid_client_jwt_credential":                         resourceKeycloakOpenidClientJwtCredential(),
+			"keycloak_saml_client":                                          resourceKeycloakSamlClient(),
+			"keycloak_saml_client_scope":                                    resourceKeycloakSamlClientScope(),
+			"keycloak_saml_client_default_scopes":                           resourceKeycloakSamlClientDefaultScopes(),
+			"keycloak_generic_client_protocol_mapper":                       resourceKeycloakGenericClientProtocolMapper(),
+			"keycloak_generic_client_role_mapper":                           resourceKeycloakGenericClientRoleMapper(),
+			"keycloak_generic_protocol_mapper":                              resourceKeycloakGenericProtocolMapper(),
+			"keycloak_generic_role_mapper":                                  resourceKeycloakGenericRoleMapper(),
+			"keycloak_client_scope_protocol_mappers":                        resourceKeycloakClientScopeProtocolMappers(),
+			"keycloak_client_scope_role_mappings":                           resourceKeycloakClientScopeRoleMappings(),
+			"keycloak_saml_user_attribute_protocol_mapper":                  resourceKeycloakSamlUserAttributeProtocolMapper(),
+			"keycloak_saml_user_property_protocol_mapper":                   resourceKeycloakSamlUserPropertyProtocolMapper(),
+			"keycloak_saml_script_protocol_mapper":                          resourceKeycloakSamlScriptProtocolMapper(),
+			"keycloak_saml_role_list_protocol_mapper":                       resourceKeycloakSamlRoleListProtocolMapper(),
+			"keycloak_saml_group_membership_protocol_mapper":                resourceKeycloakSamlGroupMembershipProtocolMapper(),
+			"keycloak_saml_hardcoded_attribute_protocol_mapper":             resourceKeycloakSamlHardcodedAttributeProtocolMapper(),
+			"keycloak_saml_hardcoded_role_protocol_mapper":                  resourceKeycloakSamlHardcodedRoleProtocolMapper(),
+			"keycloak_saml_role_name_protocol_mapper":                       resourceKeycloakSamlRoleNameProtocolMapper(),
+			"keycloak_saml_user_session_note_protocol_mapper":               resourceKeycloakSamlUserSessionNoteProtocolMapper(),
+			"keycloak_hardcoded_attribute_identity_provider_mapper":         resourceKeycloakHardcodedAttributeIdentityProviderMapper(),
+			"keycloak_hardcoded_role_identity_provider_mapper":              resourceKeycloakHardcodedRoleIdentityProviderMapper(),
+			"keycloak_hardcoded_group_identity_provider_mapper":             resourceKeycloakHardcodedGroupIdentityProviderMapper(),
+			"keycloak_attribute_importer_identity_provider_mapper":          resourceKeycloakAttributeImporterIdentityProviderMapper(),
+			"keycloak_attribute_to_role_identity_provider_mapper":           resourceKeycloakAttributeToRoleIdentityProviderMapper(),
+			"keycloak_advanced_claim_to_role_identity_provider_mapper":      resourceKeycloakAdvancedClaimToRoleIdentityProviderMapper(),
+			"keycloak_advanced_claim_to_group_identity_provider_mapper":     resourceKeycloakAdvancedClaimToGroupIdentityProviderMapper(),
+			"keycloak_advanced_attribute_to_role_identity_provider_mapper":  resourceKeycloakAdvancedAttributeToRoleIdentityProviderMapper(),
+			"keycloak_advanced_attribute_to_group_identity_provider_mapper": resourceKeycloakAdvancedAttributeToGroupIdentityProviderMapper(),
+			"keycloak_user_template_importer_identity_provider_mapper":      resourceKeycloakUserTemplateImporterIdentityProviderMapper(),
+			"keycloak_custom_identity_provider_mapper":                      resourceKeycloakCustomIdentityProviderMapper(),
+			"keycloak_saml_identity_provider":                               resourceKeycloakSamlIdentityProvider(),
+			"keycloak_oidc_google_identity_provider":                        resourceKeycloakOidcGoogleIdentityProvider(),
+			"keycloak_oidc_identity_provider":                               resourceKeycloakOidcIdentityProvider(),
+			"keycloak_github_identity_provider":                             resourceKeycloakGithubIdentityProvider(),
+			"keycloak_gitlab_identity_provider":                             resourceKeycloakGitlabIdentityProvider(),
+			"keycloak_microsoft_identity_provider":                          resourceKeycloakMicrosoftIdentityProvider(),
+			"keycloak_facebook_identity_provider":                           resourceKeycloakFacebookIdentityProvider(),
+			"keycloak_linkedin_identity_provider":                           resourceKeycloakLinkedinIdentityProvider(),
+			"keycloak_bitbucket_identity_provider":                          resourceKeycloakBitbucketIdentityProvider(),
+			"keycloak_stackoverflow_identity_provider":                      resourceKeycloakStackoverflowIdentityProvider(),
+			"keycloak_paypal_identity_provider":                             resourceKeycloakPaypalIdentityProvider(),
+			"keycloak_instagram_identity_provider":                          resourceKeycloakInstagramIdentityProvider(),
+			"keycloak_twitter_identity_provider":                            resourceKeycloakTwitterIdentityProvider(),
+			"keycloak_openshift_identity_provider":                          resourceKeycloakOpenshiftIdentityProvider(),
+			"keycloak_openid_client_authorization_resource":                 resourceKeycloakOpenidClientAuthorizationResource(),
+			"keycloak_openid_client_group_policy":                           resourceKeycloakOpenidClientAuthorizationGroupPolicy(),
+			"keycloak_openid_client_role_policy":                            resourceKeycloakOpenidClientAuthorizationRolePolicy(),
+			"keycloak_openid_client_aggregate_policy":                       resourceKeycloakOpenidClientAuthorizationAggregatePolicy(),
+			"keycloak_openid_client_js_policy":                              resourceKeycloakOpenidClientAuthorizationJSPolicy(),
+			"keycloak_openid_client_time_policy":                            resourceKeycloakOpenidClientAuthorizationTimePolicy(),
+			"keycloak_openid_client_user_policy":                            resourceKeycloakOpenidClientAuthorizationUserPolicy(),
+			"keycloak_openid_client_client_policy":                          resourceKeycloakOpenidClientAuthorizationClientPolicy(),
+			"keycloak_openid_client_authorization_scope":                    resourceKeycloakOpenidClientAuthorizationScope(),
+			"keycloak_openid_client_authorization_permission":               resourceKeycloakOpenidClientAuthorizationPermission(),
+			"keycloak_openid_client_service_account_role":                   resourceKeycloakOpenidClientServiceAccountRole(),
+			"keycloak_openid_client_service_account_realm_role":             resourceKeycloakOpenidClientServiceAccountRealmRole(),
+			"keycloak_role":                                                 resourceKeycloakRole(),
+			"keycloak_authentication_flow":                                  resourceKeycloakAuthenticationFlow(),
+			"keycloak_authentication_subflow":                               resourceKeycloakAuthenticationSubFlow(),
+			"keycloak_authentication_execution":                             resourceKeycloakAuthenticationExecution(),
+			"keycloak_authentication_execution_config":                      resourceKeycloakAuthenticationExecutionConfig(),
+			"keycloak_authentication_flow_order":                            resourceKeycloakAuthenticationFlowOrder(),
+			"keycloak_authentication_flow_tree":                             resourceKeycloakAuthenticationFlowTree(),
+			"keycloak_identity_provider_token_exchange_scope_permission":    resourceKeycloakIdentityProviderTokenExchangeScopePermission(),
+			"keycloak_openid_client_permissions":                            resourceKeycloakOpenidClientPermissions(),
+			"keycloak_openid_client_token_exchange_permission":              resourceKeycloakOpenidClientTokenExchangePermission(),
+			"keycloak_openid_client_role_scope_mappings":                    resourceKeycloakOpenidClientRoleScopeMappings(),
+			"keycloak_openid_client_protocol_mappers":                       resourceKeycloakOpenidClientProtocolMappers(),
+			"keycloak_users_permissions":                                    resourceKeycloakUsersPermissions(),
+			"keycloak_user_groups":                                          resourceKeycloakUserGroups(),
+			"keycloak_group_permissions":                                    resourceKeycloakGroupPermissions(),
+			"keycloak_authentication_bindings":                              resourceKeycloakAuthenticationBindings(),
 		},
 		Schema: map[string]*schema.Schema{
 			"client_id": {
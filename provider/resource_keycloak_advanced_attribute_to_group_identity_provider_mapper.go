@@ -0,0 +1,60 @@
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/charlesderek/terraform-w-keycloak/keycloak"
+	"github.com/charlesderek/terraform-w-keycloak/keycloak/types"
+)
+
+func resourceKeycloakAdvancedAttributeToGroupIdentityProviderMapper() *schema.Resource {
+	mapperSchema := map[string]*schema.Schema{
+		"attributes": identityProviderMapperKeyValueSchema("Attributes to match. When all attributes are present in the assertion, the user will be added to the group."),
+		"attribute_values_regex": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "If enabled, attribute values are interpreted as regular expressions.",
+		},
+		"group": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Group Path",
+		},
+	}
+	genericMapperResource := resourceKeycloakIdentityProviderMapper()
+	genericMapperResource.Schema = mergeSchemas(genericMapperResource.Schema, mapperSchema)
+	genericMapperResource.CreateContext = resourceKeycloakIdentityProviderMapperCreate(getAdvancedAttributeToGroupIdentityProviderMapperFromData, setAdvancedAttributeToGroupIdentityProviderMapperData)
+	genericMapperResource.ReadContext = resourceKeycloakIdentityProviderMapperRead(setAdvancedAttributeToGroupIdentityProviderMapperData)
+	genericMapperResource.UpdateContext = resourceKeycloakIdentityProviderMapperUpdate(getAdvancedAttributeToGroupIdentityProviderMapperFromData, setAdvancedAttributeToGroupIdentityProviderMapperData)
+	return genericMapperResource
+}
+
+func getAdvancedAttributeToGroupIdentityProviderMapperFromData(_ context.Context, data *schema.ResourceData, _ interface{}) (*keycloak.IdentityProviderMapper, error) {
+	rec, _ := getIdentityProviderMapperFromData(data)
+
+	attributes, err := getIdentityProviderMapperKeyValuesFromData(data, "attributes")
+	if err != nil {
+		return nil, err
+	}
+
+	rec.IdentityProviderMapper = "saml-advanced-group-idp-mapper"
+	rec.Config.Attributes = attributes
+	rec.Config.AreAttributeValuesRegex = types.KeycloakBoolQuoted(data.Get("attribute_values_regex").(bool))
+	rec.Config.Group = data.Get("group").(string)
+
+	return rec, nil
+}
+
+func setAdvancedAttributeToGroupIdentityProviderMapperData(data *schema.ResourceData, identityProviderMapper *keycloak.IdentityProviderMapper) error {
+	setIdentityProviderMapperData(data, identityProviderMapper)
+
+	if err := setIdentityProviderMapperKeyValuesData(data, "attributes", identityProviderMapper.Config.Attributes); err != nil {
+		return err
+	}
+
+	data.Set("attribute_values_regex", identityProviderMapper.Config.AreAttributeValuesRegex)
+	data.Set("group", identityProviderMapper.Config.Group)
+
+	return nil
+}
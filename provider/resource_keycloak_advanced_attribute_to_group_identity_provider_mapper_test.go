@@ -0,0 +1,254 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/charlesderek/terraform-w-keycloak/keycloak"
+)
+
+func TestAccKeycloakAdvancedAttributeToGroupIdentityProviderMapper_basic(t *testing.T) {
+	t.Parallel()
+
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+	alias := acctest.RandomWithPrefix("tf-acc")
+	groupName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakAdvancedAttributeToGroupIdentityProviderMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakAdvancedAttributeToGroupIdentityProviderMapper_basic(alias, mapperName, groupName),
+				Check:  testAccCheckKeycloakAdvancedAttributeToGroupIdentityProviderMapperExists("keycloak_advanced_attribute_to_group_identity_provider_mapper.saml"),
+			},
+			{
+				ResourceName:      "keycloak_advanced_attribute_to_group_identity_provider_mapper.saml",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: getKeycloakAdvancedAttributeToGroupIdentityProviderMapperImportId("keycloak_advanced_attribute_to_group_identity_provider_mapper.saml"),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakAdvancedAttributeToGroupIdentityProviderMapper_updateAttributes(t *testing.T) {
+	t.Parallel()
+
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+	alias := acctest.RandomWithPrefix("tf-acc")
+	groupName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakAdvancedAttributeToGroupIdentityProviderMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakAdvancedAttributeToGroupIdentityProviderMapper_basic(alias, mapperName, groupName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakAdvancedAttributeToGroupIdentityProviderMapperExists("keycloak_advanced_attribute_to_group_identity_provider_mapper.saml"),
+					resource.TestCheckResourceAttr("keycloak_advanced_attribute_to_group_identity_provider_mapper.saml", "attributes.#", "1"),
+				),
+			},
+			{
+				Config: testKeycloakAdvancedAttributeToGroupIdentityProviderMapper_regex(alias, mapperName, groupName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakAdvancedAttributeToGroupIdentityProviderMapperExists("keycloak_advanced_attribute_to_group_identity_provider_mapper.saml"),
+					resource.TestCheckResourceAttr("keycloak_advanced_attribute_to_group_identity_provider_mapper.saml", "attributes.#", "2"),
+					resource.TestCheckResourceAttr("keycloak_advanced_attribute_to_group_identity_provider_mapper.saml", "attribute_values_regex", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakAdvancedAttributeToGroupIdentityProviderMapper_createAfterManualDestroy(t *testing.T) {
+	t.Parallel()
+
+	var mapper = &keycloak.IdentityProviderMapper{}
+
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+	alias := acctest.RandomWithPrefix("tf-acc")
+	groupName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakAdvancedAttributeToGroupIdentityProviderMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakAdvancedAttributeToGroupIdentityProviderMapper_basic(alias, mapperName, groupName),
+				Check:  testAccCheckKeycloakAdvancedAttributeToGroupIdentityProviderMapperFetch("keycloak_advanced_attribute_to_group_identity_provider_mapper.saml", mapper),
+			},
+			{
+				PreConfig: func() {
+					err := keycloakClient.DeleteIdentityProviderMapper(testCtx, mapper.Realm, mapper.IdentityProviderAlias, mapper.Id)
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testKeycloakAdvancedAttributeToGroupIdentityProviderMapper_basic(alias, mapperName, groupName),
+				Check:  testAccCheckKeycloakAdvancedAttributeToGroupIdentityProviderMapperExists("keycloak_advanced_attribute_to_group_identity_provider_mapper.saml"),
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakAdvancedAttributeToGroupIdentityProviderMapperExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		mapper, err := getKeycloakAdvancedAttributeToGroupIdentityProviderMapperFromState(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		if mapper.IdentityProviderMapper != "saml-advanced-group-idp-mapper" {
+			return fmt.Errorf("expected mapper type to be saml-advanced-group-idp-mapper, but was %s", mapper.IdentityProviderMapper)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKeycloakAdvancedAttributeToGroupIdentityProviderMapperFetch(resourceName string, mapper *keycloak.IdentityProviderMapper) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		fetchedMapper, err := getKeycloakAdvancedAttributeToGroupIdentityProviderMapperFromState(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		mapper.IdentityProviderAlias = fetchedMapper.IdentityProviderAlias
+		mapper.Realm = fetchedMapper.Realm
+		mapper.Id = fetchedMapper.Id
+
+		return nil
+	}
+}
+
+func testAccCheckKeycloakAdvancedAttributeToGroupIdentityProviderMapperDestroy() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "keycloak_advanced_attribute_to_group_identity_provider_mapper" {
+				continue
+			}
+
+			realm := rs.Primary.Attributes["realm"]
+			alias := rs.Primary.Attributes["identity_provider_alias"]
+			id := rs.Primary.ID
+
+			mapper, _ := keycloakClient.GetIdentityProviderMapper(testCtx, realm, alias, id)
+			if mapper != nil {
+				return fmt.Errorf("identity provider mapper with id %s still exists", id)
+			}
+		}
+
+		return nil
+	}
+}
+
+func getKeycloakAdvancedAttributeToGroupIdentityProviderMapperFromState(s *terraform.State, resourceName string) (*keycloak.IdentityProviderMapper, error) {
+	rs, ok := s.RootModule().Resources[resourceName]
+	if !ok {
+		return nil, fmt.Errorf("resource not found: %s", resourceName)
+	}
+
+	realm := rs.Primary.Attributes["realm"]
+	alias := rs.Primary.Attributes["identity_provider_alias"]
+	id := rs.Primary.ID
+
+	mapper, err := keycloakClient.GetIdentityProviderMapper(testCtx, realm, alias, id)
+	if err != nil {
+		return nil, fmt.Errorf("error getting identity provider mapper config with id %s: %s", id, err)
+	}
+
+	return mapper, nil
+}
+
+func getKeycloakAdvancedAttributeToGroupIdentityProviderMapperImportId(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		realm := rs.Primary.Attributes["realm"]
+		alias := rs.Primary.Attributes["identity_provider_alias"]
+		id := rs.Primary.ID
+
+		return fmt.Sprintf("%s/%s/%s", realm, alias, id), nil
+	}
+}
+
+func testKeycloakAdvancedAttributeToGroupIdentityProviderMapper_basic(alias, name, groupName string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_saml_identity_provider" "saml" {
+	realm                      = data.keycloak_realm.realm.id
+	alias                      = "%s"
+	entity_id                  = "https://example.com/entity_id"
+	single_sign_on_service_url = "https://example.com/auth"
+}
+
+resource "keycloak_group" "group" {
+	realm_id = data.keycloak_realm.realm.id
+	name     = "%s"
+}
+
+resource "keycloak_advanced_attribute_to_group_identity_provider_mapper" "saml" {
+	realm                   = data.keycloak_realm.realm.id
+	name                    = "%s"
+	identity_provider_alias = keycloak_saml_identity_provider.saml.alias
+	group                   = "/${keycloak_group.group.name}"
+
+	attributes {
+		key   = "department"
+		value = "engineering"
+	}
+}
+	`, testAccRealm.Realm, alias, groupName, name)
+}
+
+func testKeycloakAdvancedAttributeToGroupIdentityProviderMapper_regex(alias, name, groupName string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_saml_identity_provider" "saml" {
+	realm                      = data.keycloak_realm.realm.id
+	alias                      = "%s"
+	entity_id                  = "https://example.com/entity_id"
+	single_sign_on_service_url = "https://example.com/auth"
+}
+
+resource "keycloak_group" "group" {
+	realm_id = data.keycloak_realm.realm.id
+	name     = "%s"
+}
+
+resource "keycloak_advanced_attribute_to_group_identity_provider_mapper" "saml" {
+	realm                   = data.keycloak_realm.realm.id
+	name                    = "%s"
+	identity_provider_alias = keycloak_saml_identity_provider.saml.alias
+	group                   = "/${keycloak_group.group.name}"
+	attribute_values_regex  = true
+
+	attributes {
+		key   = "department"
+		value = "eng.*"
+	}
+
+	attributes {
+		key   = "country"
+		value = "(US|CA)"
+	}
+}
+	`, testAccRealm.Realm, alias, groupName, name)
+}
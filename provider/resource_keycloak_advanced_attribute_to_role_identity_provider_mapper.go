@@ -0,0 +1,60 @@
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/charlesderek/terraform-w-keycloak/keycloak"
+	"github.com/charlesderek/terraform-w-keycloak/keycloak/types"
+)
+
+func resourceKeycloakAdvancedAttributeToRoleIdentityProviderMapper() *schema.Resource {
+	mapperSchema := map[string]*schema.Schema{
+		"attributes": identityProviderMapperKeyValueSchema("Attributes to match. When all attributes are present in the assertion, the user will be granted the role."),
+		"attribute_values_regex": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "If enabled, attribute values are interpreted as regular expressions.",
+		},
+		"role": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Role Name",
+		},
+	}
+	genericMapperResource := resourceKeycloakIdentityProviderMapper()
+	genericMapperResource.Schema = mergeSchemas(genericMapperResource.Schema, mapperSchema)
+	genericMapperResource.CreateContext = resourceKeycloakIdentityProviderMapperCreate(getAdvancedAttributeToRoleIdentityProviderMapperFromData, setAdvancedAttributeToRoleIdentityProviderMapperData)
+	genericMapperResource.ReadContext = resourceKeycloakIdentityProviderMapperRead(setAdvancedAttributeToRoleIdentityProviderMapperData)
+	genericMapperResource.UpdateContext = resourceKeycloakIdentityProviderMapperUpdate(getAdvancedAttributeToRoleIdentityProviderMapperFromData, setAdvancedAttributeToRoleIdentityProviderMapperData)
+	return genericMapperResource
+}
+
+func getAdvancedAttributeToRoleIdentityProviderMapperFromData(_ context.Context, data *schema.ResourceData, _ interface{}) (*keycloak.IdentityProviderMapper, error) {
+	rec, _ := getIdentityProviderMapperFromData(data)
+
+	attributes, err := getIdentityProviderMapperKeyValuesFromData(data, "attributes")
+	if err != nil {
+		return nil, err
+	}
+
+	rec.IdentityProviderMapper = "saml-advanced-role-idp-mapper"
+	rec.Config.Attributes = attributes
+	rec.Config.AreAttributeValuesRegex = types.KeycloakBoolQuoted(data.Get("attribute_values_regex").(bool))
+	rec.Config.Role = data.Get("role").(string)
+
+	return rec, nil
+}
+
+func setAdvancedAttributeToRoleIdentityProviderMapperData(data *schema.ResourceData, identityProviderMapper *keycloak.IdentityProviderMapper) error {
+	setIdentityProviderMapperData(data, identityProviderMapper)
+
+	if err := setIdentityProviderMapperKeyValuesData(data, "attributes", identityProviderMapper.Config.Attributes); err != nil {
+		return err
+	}
+
+	data.Set("attribute_values_regex", identityProviderMapper.Config.AreAttributeValuesRegex)
+	data.Set("role", identityProviderMapper.Config.Role)
+
+	return nil
+}
@@ -0,0 +1,60 @@
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/charlesderek/terraform-w-keycloak/keycloak"
+	"github.com/charlesderek/terraform-w-keycloak/keycloak/types"
+)
+
+func resourceKeycloakAdvancedClaimToGroupIdentityProviderMapper() *schema.Resource {
+	mapperSchema := map[string]*schema.Schema{
+		"claims": identityProviderMapperKeyValueSchema("Claims to match. When all claims are present in the token, the user will be added to the group."),
+		"claim_values_regex": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "If enabled, claim values are interpreted as regular expressions.",
+		},
+		"group": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Group Path",
+		},
+	}
+	genericMapperResource := resourceKeycloakIdentityProviderMapper()
+	genericMapperResource.Schema = mergeSchemas(genericMapperResource.Schema, mapperSchema)
+	genericMapperResource.CreateContext = resourceKeycloakIdentityProviderMapperCreate(getAdvancedClaimToGroupIdentityProviderMapperFromData, setAdvancedClaimToGroupIdentityProviderMapperData)
+	genericMapperResource.ReadContext = resourceKeycloakIdentityProviderMapperRead(setAdvancedClaimToGroupIdentityProviderMapperData)
+	genericMapperResource.UpdateContext = resourceKeycloakIdentityProviderMapperUpdate(getAdvancedClaimToGroupIdentityProviderMapperFromData, setAdvancedClaimToGroupIdentityProviderMapperData)
+	return genericMapperResource
+}
+
+func getAdvancedClaimToGroupIdentityProviderMapperFromData(_ context.Context, data *schema.ResourceData, _ interface{}) (*keycloak.IdentityProviderMapper, error) {
+	rec, _ := getIdentityProviderMapperFromData(data)
+
+	claims, err := getIdentityProviderMapperKeyValuesFromData(data, "claims")
+	if err != nil {
+		return nil, err
+	}
+
+	rec.IdentityProviderMapper = "oidc-advanced-group-idp-mapper"
+	rec.Config.Claims = claims
+	rec.Config.AreClaimValuesRegex = types.KeycloakBoolQuoted(data.Get("claim_values_regex").(bool))
+	rec.Config.Group = data.Get("group").(string)
+
+	return rec, nil
+}
+
+func setAdvancedClaimToGroupIdentityProviderMapperData(data *schema.ResourceData, identityProviderMapper *keycloak.IdentityProviderMapper) error {
+	setIdentityProviderMapperData(data, identityProviderMapper)
+
+	if err := setIdentityProviderMapperKeyValuesData(data, "claims", identityProviderMapper.Config.Claims); err != nil {
+		return err
+	}
+
+	data.Set("claim_values_regex", identityProviderMapper.Config.AreClaimValuesRegex)
+	data.Set("group", identityProviderMapper.Config.Group)
+
+	return nil
+}
@@ -0,0 +1,60 @@
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/charlesderek/terraform-w-keycloak/keycloak"
+	"github.com/charlesderek/terraform-w-keycloak/keycloak/types"
+)
+
+func resourceKeycloakAdvancedClaimToRoleIdentityProviderMapper() *schema.Resource {
+	mapperSchema := map[string]*schema.Schema{
+		"claims": identityProviderMapperKeyValueSchema("Claims to match. When all claims are present in the token, the user will be granted the role."),
+		"claim_values_regex": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "If enabled, claim values are interpreted as regular expressions.",
+		},
+		"role": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Role Name",
+		},
+	}
+	genericMapperResource := resourceKeycloakIdentityProviderMapper()
+	genericMapperResource.Schema = mergeSchemas(genericMapperResource.Schema, mapperSchema)
+	genericMapperResource.CreateContext = resourceKeycloakIdentityProviderMapperCreate(getAdvancedClaimToRoleIdentityProviderMapperFromData, setAdvancedClaimToRoleIdentityProviderMapperData)
+	genericMapperResource.ReadContext = resourceKeycloakIdentityProviderMapperRead(setAdvancedClaimToRoleIdentityProviderMapperData)
+	genericMapperResource.UpdateContext = resourceKeycloakIdentityProviderMapperUpdate(getAdvancedClaimToRoleIdentityProviderMapperFromData, setAdvancedClaimToRoleIdentityProviderMapperData)
+	return genericMapperResource
+}
+
+func getAdvancedClaimToRoleIdentityProviderMapperFromData(_ context.Context, data *schema.ResourceData, _ interface{}) (*keycloak.IdentityProviderMapper, error) {
+	rec, _ := getIdentityProviderMapperFromData(data)
+
+	claims, err := getIdentityProviderMapperKeyValuesFromData(data, "claims")
+	if err != nil {
+		return nil, err
+	}
+
+	rec.IdentityProviderMapper = "oidc-advanced-role-idp-mapper"
+	rec.Config.Claims = claims
+	rec.Config.AreClaimValuesRegex = types.KeycloakBoolQuoted(data.Get("claim_values_regex").(bool))
+	rec.Config.Role = data.Get("role").(string)
+
+	return rec, nil
+}
+
+func setAdvancedClaimToRoleIdentityProviderMapperData(data *schema.ResourceData, identityProviderMapper *keycloak.IdentityProviderMapper) error {
+	setIdentityProviderMapperData(data, identityProviderMapper)
+
+	if err := setIdentityProviderMapperKeyValuesData(data, "claims", identityProviderMapper.Config.Claims); err != nil {
+		return err
+	}
+
+	data.Set("claim_values_regex", identityProviderMapper.Config.AreClaimValuesRegex)
+	data.Set("role", identityProviderMapper.Config.Role)
+
+	return nil
+}
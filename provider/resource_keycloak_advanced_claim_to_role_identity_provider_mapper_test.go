@@ -0,0 +1,258 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/charlesderek/terraform-w-keycloak/keycloak"
+)
+
+func TestAccKeycloakAdvancedClaimToRoleIdentityProviderMapper_basic(t *testing.T) {
+	t.Parallel()
+
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+	alias := acctest.RandomWithPrefix("tf-acc")
+	roleName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakAdvancedClaimToRoleIdentityProviderMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakAdvancedClaimToRoleIdentityProviderMapper_basic(alias, mapperName, roleName),
+				Check:  testAccCheckKeycloakAdvancedClaimToRoleIdentityProviderMapperExists("keycloak_advanced_claim_to_role_identity_provider_mapper.oidc"),
+			},
+			{
+				ResourceName:      "keycloak_advanced_claim_to_role_identity_provider_mapper.oidc",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: getKeycloakAdvancedClaimToRoleIdentityProviderMapperImportId("keycloak_advanced_claim_to_role_identity_provider_mapper.oidc"),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakAdvancedClaimToRoleIdentityProviderMapper_updateClaims(t *testing.T) {
+	t.Parallel()
+
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+	alias := acctest.RandomWithPrefix("tf-acc")
+	roleName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakAdvancedClaimToRoleIdentityProviderMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakAdvancedClaimToRoleIdentityProviderMapper_basic(alias, mapperName, roleName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakAdvancedClaimToRoleIdentityProviderMapperExists("keycloak_advanced_claim_to_role_identity_provider_mapper.oidc"),
+					resource.TestCheckResourceAttr("keycloak_advanced_claim_to_role_identity_provider_mapper.oidc", "claims.#", "1"),
+				),
+			},
+			{
+				Config: testKeycloakAdvancedClaimToRoleIdentityProviderMapper_regex(alias, mapperName, roleName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakAdvancedClaimToRoleIdentityProviderMapperExists("keycloak_advanced_claim_to_role_identity_provider_mapper.oidc"),
+					resource.TestCheckResourceAttr("keycloak_advanced_claim_to_role_identity_provider_mapper.oidc", "claims.#", "2"),
+					resource.TestCheckResourceAttr("keycloak_advanced_claim_to_role_identity_provider_mapper.oidc", "claim_values_regex", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakAdvancedClaimToRoleIdentityProviderMapper_createAfterManualDestroy(t *testing.T) {
+	t.Parallel()
+
+	var mapper = &keycloak.IdentityProviderMapper{}
+
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+	alias := acctest.RandomWithPrefix("tf-acc")
+	roleName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakAdvancedClaimToRoleIdentityProviderMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakAdvancedClaimToRoleIdentityProviderMapper_basic(alias, mapperName, roleName),
+				Check:  testAccCheckKeycloakAdvancedClaimToRoleIdentityProviderMapperFetch("keycloak_advanced_claim_to_role_identity_provider_mapper.oidc", mapper),
+			},
+			{
+				PreConfig: func() {
+					err := keycloakClient.DeleteIdentityProviderMapper(testCtx, mapper.Realm, mapper.IdentityProviderAlias, mapper.Id)
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testKeycloakAdvancedClaimToRoleIdentityProviderMapper_basic(alias, mapperName, roleName),
+				Check:  testAccCheckKeycloakAdvancedClaimToRoleIdentityProviderMapperExists("keycloak_advanced_claim_to_role_identity_provider_mapper.oidc"),
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakAdvancedClaimToRoleIdentityProviderMapperExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		mapper, err := getKeycloakAdvancedClaimToRoleIdentityProviderMapperFromState(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		if mapper.IdentityProviderMapper != "oidc-advanced-role-idp-mapper" {
+			return fmt.Errorf("expected mapper type to be oidc-advanced-role-idp-mapper, but was %s", mapper.IdentityProviderMapper)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKeycloakAdvancedClaimToRoleIdentityProviderMapperFetch(resourceName string, mapper *keycloak.IdentityProviderMapper) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		fetchedMapper, err := getKeycloakAdvancedClaimToRoleIdentityProviderMapperFromState(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		mapper.IdentityProviderAlias = fetchedMapper.IdentityProviderAlias
+		mapper.Realm = fetchedMapper.Realm
+		mapper.Id = fetchedMapper.Id
+
+		return nil
+	}
+}
+
+func testAccCheckKeycloakAdvancedClaimToRoleIdentityProviderMapperDestroy() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "keycloak_advanced_claim_to_role_identity_provider_mapper" {
+				continue
+			}
+
+			realm := rs.Primary.Attributes["realm"]
+			alias := rs.Primary.Attributes["identity_provider_alias"]
+			id := rs.Primary.ID
+
+			mapper, _ := keycloakClient.GetIdentityProviderMapper(testCtx, realm, alias, id)
+			if mapper != nil {
+				return fmt.Errorf("identity provider mapper with id %s still exists", id)
+			}
+		}
+
+		return nil
+	}
+}
+
+func getKeycloakAdvancedClaimToRoleIdentityProviderMapperFromState(s *terraform.State, resourceName string) (*keycloak.IdentityProviderMapper, error) {
+	rs, ok := s.RootModule().Resources[resourceName]
+	if !ok {
+		return nil, fmt.Errorf("resource not found: %s", resourceName)
+	}
+
+	realm := rs.Primary.Attributes["realm"]
+	alias := rs.Primary.Attributes["identity_provider_alias"]
+	id := rs.Primary.ID
+
+	mapper, err := keycloakClient.GetIdentityProviderMapper(testCtx, realm, alias, id)
+	if err != nil {
+		return nil, fmt.Errorf("error getting identity provider mapper config with id %s: %s", id, err)
+	}
+
+	return mapper, nil
+}
+
+func getKeycloakAdvancedClaimToRoleIdentityProviderMapperImportId(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		realm := rs.Primary.Attributes["realm"]
+		alias := rs.Primary.Attributes["identity_provider_alias"]
+		id := rs.Primary.ID
+
+		return fmt.Sprintf("%s/%s/%s", realm, alias, id), nil
+	}
+}
+
+func testKeycloakAdvancedClaimToRoleIdentityProviderMapper_basic(alias, name, roleName string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_oidc_identity_provider" "oidc" {
+	realm             = data.keycloak_realm.realm.id
+	alias             = "%s"
+	authorization_url = "https://example.com/auth"
+	token_url         = "https://example.com/token"
+	client_id         = "example_id"
+	client_secret     = "example_token"
+}
+
+resource "keycloak_role" "role" {
+	realm_id = data.keycloak_realm.realm.id
+	name     = "%s"
+}
+
+resource "keycloak_advanced_claim_to_role_identity_provider_mapper" "oidc" {
+	realm                   = data.keycloak_realm.realm.id
+	name                    = "%s"
+	identity_provider_alias = keycloak_oidc_identity_provider.oidc.alias
+	role                    = keycloak_role.role.name
+
+	claims {
+		key   = "department"
+		value = "engineering"
+	}
+}
+	`, testAccRealm.Realm, alias, roleName, name)
+}
+
+func testKeycloakAdvancedClaimToRoleIdentityProviderMapper_regex(alias, name, roleName string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_oidc_identity_provider" "oidc" {
+	realm             = data.keycloak_realm.realm.id
+	alias             = "%s"
+	authorization_url = "https://example.com/auth"
+	token_url         = "https://example.com/token"
+	client_id         = "example_id"
+	client_secret     = "example_token"
+}
+
+resource "keycloak_role" "role" {
+	realm_id = data.keycloak_realm.realm.id
+	name     = "%s"
+}
+
+resource "keycloak_advanced_claim_to_role_identity_provider_mapper" "oidc" {
+	realm                   = data.keycloak_realm.realm.id
+	name                    = "%s"
+	identity_provider_alias = keycloak_oidc_identity_provider.oidc.alias
+	role                    = keycloak_role.role.name
+	claim_values_regex      = true
+
+	claims {
+		key   = "department"
+		value = "eng.*"
+	}
+
+	claims {
+		key   = "country"
+		value = "(US|CA)"
+	}
+}
+	`, testAccRealm.Realm, alias, roleName, name)
+}
@@ -44,6 +44,7 @@ func resourceKeycloakAuthenticationExecution() *schema.Resource {
 				Default:      "DISABLED",
 			},
 		},
+		CustomizeDiff: validateAuthenticatorCustomizeDiff,
 	}
 }
 
@@ -137,17 +138,28 @@ func resourceKeycloakAuthenticationExecutionImport(ctx context.Context, d *schem
 	parts := strings.Split(d.Id(), "/")
 
 	if len(parts) != 3 {
-		return nil, fmt.Errorf("Invalid import. Supported import formats: {{realmId}}/{{parentFlowAlias}}/{{authenticationExecutionId}}")
+		return nil, fmt.Errorf("Invalid import. Supported import formats: {{realmId}}/{{parentFlowAlias}}/{{authenticationExecutionId}}, {{realmId}}/{{parentFlowAlias}}/{{authenticator}}")
 	}
 
-	_, err := keycloakClient.GetAuthenticationExecution(ctx, parts[0], parts[1], parts[2])
+	id := parts[2]
+
+	_, err := keycloakClient.GetAuthenticationExecution(ctx, parts[0], parts[1], id)
 	if err != nil {
-		return nil, err
+		if !keycloak.ErrorIs404(err) {
+			return nil, err
+		}
+
+		// executions created by copying a flow can also be imported using their authenticator
+		authenticationExecutionInfo, err := keycloakClient.GetAuthenticationExecutionInfoFromProviderId(ctx, parts[0], parts[1], id)
+		if err != nil {
+			return nil, err
+		}
+		id = authenticationExecutionInfo.Id
 	}
 
 	d.Set("realm_id", parts[0])
 	d.Set("parent_flow_alias", parts[1])
-	d.SetId(parts[2])
+	d.SetId(id)
 
 	diagnostics := resourceKeycloakAuthenticationExecutionRead(ctx, d, meta)
 	if diagnostics.HasError() {
@@ -42,6 +42,7 @@ func resourceKeycloakAuthenticationExecutionConfig() *schema.Resource {
 				Required: true,
 			},
 		},
+		CustomizeDiff: validateAuthenticationExecutionConfigCustomizeDiff,
 	}
 }
 
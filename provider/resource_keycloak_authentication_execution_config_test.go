@@ -3,6 +3,7 @@ package provider
 import (
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -184,6 +185,29 @@ func testAccCheckKeycloakAuthenticationExecutionConfigForceNew(old, new *keycloa
 	}
 }
 
+func TestAccKeycloakAuthenticationExecutionConfig_invalidConfigKey(t *testing.T) {
+	t.Parallel()
+
+	flowAlias := acctest.RandomWithPrefix("tf-acc")
+	configAlias := acctest.RandomWithPrefix("tf-acc")
+	configProvider := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakAuthenticationExecutionConfigDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeycloakAuthenticationExecutionConfig(flowAlias, configAlias, configProvider),
+			},
+			{
+				Config:      testAccKeycloakAuthenticationExecutionConfigWithKey(flowAlias, configAlias, "defaultProvidr", configProvider),
+				ExpectError: regexp.MustCompile("config key defaultProvidr is not supported by authenticator identity-provider-redirector, did you mean defaultProvider\\?"),
+			},
+		},
+	})
+}
+
 func testAccKeycloakAuthenticationExecutionConfig(flowAlias, configAlias, configProvider string) string {
 	return fmt.Sprintf(`
 data "keycloak_realm" "realm" {
@@ -210,3 +234,30 @@ resource "keycloak_authentication_execution_config" "config" {
 	}
 }`, testAccRealm.Realm, flowAlias, configAlias, configProvider)
 }
+
+func testAccKeycloakAuthenticationExecutionConfigWithKey(flowAlias, configAlias, configKey, configValue string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_authentication_flow" "flow" {
+	realm_id = data.keycloak_realm.realm.id
+	alias    = "%s"
+}
+
+resource "keycloak_authentication_execution" "execution" {
+	realm_id          = data.keycloak_realm.realm.id
+	parent_flow_alias = keycloak_authentication_flow.flow.alias
+	authenticator     = "identity-provider-redirector"
+}
+
+resource "keycloak_authentication_execution_config" "config" {
+	realm_id     = data.keycloak_realm.realm.id
+	execution_id = keycloak_authentication_execution.execution.id
+	alias        = "%s"
+	config = {
+		%s = "%s"
+	}
+}`, testAccRealm.Realm, flowAlias, configAlias, configKey, configValue)
+}
@@ -2,6 +2,7 @@ package provider
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -29,6 +30,12 @@ func TestAccKeycloakAuthenticationExecution_basic(t *testing.T) {
 				ImportStateVerify: true,
 				ImportStateIdFunc: getExecutionImportId("keycloak_authentication_execution.execution"),
 			},
+			{
+				ResourceName:      "keycloak_authentication_execution.execution",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: getExecutionImportIdFromAuthenticator("keycloak_authentication_execution.execution"),
+			},
 		},
 	})
 }
@@ -99,6 +106,23 @@ func TestAccKeycloakAuthenticationExecution_updateAuthenticationExecutionRequire
 	})
 }
 
+func TestAccKeycloakAuthenticationExecution_invalidAuthenticator(t *testing.T) {
+	t.Parallel()
+	parentAuthFlowAlias := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakAuthenticationExecutionDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakAuthenticationExecution_withAuthenticator(parentAuthFlowAlias, "auth-cookei"),
+				ExpectError: regexp.MustCompile("authenticator auth-cookei is not installed on the server, did you mean auth-cookie\\?"),
+			},
+		},
+	})
+}
+
 func testAccCheckKeycloakAuthenticationExecutionExists(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		_, err := getAuthenticationExecutionFromState(s, resourceName)
@@ -180,6 +204,21 @@ func getExecutionImportId(resourceName string) resource.ImportStateIdFunc {
 	}
 }
 
+func getExecutionImportIdFromAuthenticator(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		authenticator := rs.Primary.Attributes["authenticator"]
+		parentFlowAlias := rs.Primary.Attributes["parent_flow_alias"]
+		realmId := rs.Primary.Attributes["realm_id"]
+
+		return fmt.Sprintf("%s/%s/%s", realmId, parentFlowAlias, authenticator), nil
+	}
+}
+
 func testKeycloakAuthenticationExecution_basic(parentAlias string) string {
 	return fmt.Sprintf(`
 data "keycloak_realm" "realm" {
@@ -218,3 +257,22 @@ resource "keycloak_authentication_execution" "execution" {
 }
 	`, testAccRealm.Realm, parentAlias, requirement)
 }
+
+func testKeycloakAuthenticationExecution_withAuthenticator(parentAlias, authenticator string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_authentication_flow" "flow" {
+	realm_id = data.keycloak_realm.realm.id
+	alias    = "%s"
+}
+
+resource "keycloak_authentication_execution" "execution" {
+	realm_id          = data.keycloak_realm.realm.id
+	parent_flow_alias = keycloak_authentication_flow.flow.alias
+	authenticator     = "%s"
+}
+	`, testAccRealm.Realm, parentAlias, authenticator)
+}
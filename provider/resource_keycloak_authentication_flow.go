@@ -40,6 +40,60 @@ func resourceKeycloakAuthenticationFlow() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"copy_from": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The alias of an existing flow to copy, including all of its executions and subflows.",
+			},
+			"executions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"parent_flow_alias": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"display_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"authenticator": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"requirement": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"authentication_flow": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"flow_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"authentication_config": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"level": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"index": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -64,6 +118,42 @@ func mapFromAuthenticationFlowToData(data *schema.ResourceData, authenticationFl
 	data.Set("description", authenticationFlow.Description)
 }
 
+// flattenAuthenticationFlowExecutions converts the depth-first list of executions that Keycloak returns for a flow into a
+// list where each execution knows the alias of the flow it belongs to, so it can be adopted by the execution and subflow resources
+func flattenAuthenticationFlowExecutions(flowAlias string, authenticationExecutions keycloak.AuthenticationExecutionList) []interface{} {
+	var result []interface{}
+
+	// parentAliases[level] holds the alias of the flow that executions at that level belong to
+	parentAliases := []string{flowAlias}
+
+	for _, authenticationExecution := range authenticationExecutions {
+		level := authenticationExecution.Level
+		if level >= len(parentAliases) {
+			level = len(parentAliases) - 1
+		}
+		parentAliases = parentAliases[:level+1]
+
+		result = append(result, map[string]interface{}{
+			"id":                    authenticationExecution.Id,
+			"parent_flow_alias":     parentAliases[level],
+			"display_name":          authenticationExecution.DisplayName,
+			"authenticator":         authenticationExecution.ProviderId,
+			"requirement":           authenticationExecution.Requirement,
+			"authentication_flow":   authenticationExecution.AuthenticationFlow,
+			"flow_id":               authenticationExecution.FlowId,
+			"authentication_config": authenticationExecution.AuthenticationConfig,
+			"level":                 authenticationExecution.Level,
+			"index":                 authenticationExecution.Index,
+		})
+
+		if authenticationExecution.AuthenticationFlow {
+			parentAliases = append(parentAliases, authenticationExecution.DisplayName)
+		}
+	}
+
+	return result
+}
+
 func mapFromAuthenticationFlowInfoToData(data *schema.ResourceData, authenticationFlow *keycloak.AuthenticationFlow) {
 	data.SetId(authenticationFlow.Id)
 	data.Set("realm_id", authenticationFlow.RealmId)
@@ -75,9 +165,31 @@ func resourceKeycloakAuthenticationFlowCreate(ctx context.Context, data *schema.
 
 	authenticationFlow := mapFromDataToAuthenticationFlow(data)
 
-	err := keycloakClient.NewAuthenticationFlow(ctx, authenticationFlow)
-	if err != nil {
-		return diag.FromErr(err)
+	if copyFrom, ok := data.GetOk("copy_from"); ok {
+		sourceAuthenticationFlow, err := keycloakClient.GetAuthenticationFlowFromAlias(ctx, authenticationFlow.RealmId, copyFrom.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if sourceAuthenticationFlow.ProviderId != authenticationFlow.ProviderId {
+			return diag.Errorf("provider_id %s does not match the provider id of flow %s, which is %s", authenticationFlow.ProviderId, sourceAuthenticationFlow.Alias, sourceAuthenticationFlow.ProviderId)
+		}
+
+		err = keycloakClient.CopyAuthenticationFlow(ctx, authenticationFlow, sourceAuthenticationFlow.Alias)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		// the copy keeps the description of the original flow
+		err = keycloakClient.UpdateAuthenticationFlow(ctx, authenticationFlow)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	} else {
+		err := keycloakClient.NewAuthenticationFlow(ctx, authenticationFlow)
+		if err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
 	mapFromAuthenticationFlowToData(data, authenticationFlow)
@@ -96,7 +208,14 @@ func resourceKeycloakAuthenticationFlowRead(ctx context.Context, data *schema.Re
 		return handleNotFoundError(ctx, err, data)
 	}
 
+	authenticationExecutions, err := keycloakClient.ListAuthenticationExecutions(ctx, realmId, authenticationFlow.Alias)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	mapFromAuthenticationFlowToData(data, authenticationFlow)
+	data.Set("executions", flattenAuthenticationFlowExecutions(authenticationFlow.Alias, authenticationExecutions))
+
 	return nil
 }
 
@@ -0,0 +1,125 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/charlesderek/terraform-w-keycloak/keycloak"
+	"strings"
+)
+
+func resourceKeycloakAuthenticationFlowOrder() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKeycloakAuthenticationFlowOrderCreate,
+		ReadContext:   resourceKeycloakAuthenticationFlowOrderRead,
+		DeleteContext: resourceKeycloakAuthenticationFlowOrderDelete,
+		UpdateContext: resourceKeycloakAuthenticationFlowOrderUpdate,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceKeycloakAuthenticationFlowOrderImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"parent_flow_alias": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"execution_ids": {
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Required:    true,
+				MinItems:    1,
+				Description: "The ids of the executions and subflows within the parent flow, in the order they should run.",
+			},
+		},
+	}
+}
+
+func resourceKeycloakAuthenticationFlowOrderReconcile(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	parentFlowAlias := data.Get("parent_flow_alias").(string)
+
+	var executionIds []string
+	for _, executionId := range data.Get("execution_ids").([]interface{}) {
+		executionIds = append(executionIds, executionId.(string))
+	}
+
+	err := keycloakClient.ReorderAuthenticationFlowChildren(ctx, realmId, parentFlowAlias, executionIds)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(fmt.Sprintf("%s/%s", realmId, parentFlowAlias))
+
+	return resourceKeycloakAuthenticationFlowOrderRead(ctx, data, meta)
+}
+
+func resourceKeycloakAuthenticationFlowOrderCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceKeycloakAuthenticationFlowOrderReconcile(ctx, data, meta)
+}
+
+func resourceKeycloakAuthenticationFlowOrderRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	parentFlowAlias := data.Get("parent_flow_alias").(string)
+
+	children, err := keycloakClient.ListAuthenticationFlowChildren(ctx, realmId, parentFlowAlias)
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	// only the children that are managed by this resource are tracked, so executions that are added to the flow by other
+	// means don't cause a diff. when nothing is tracked yet (such as after an import), every child is tracked.
+	managedExecutionIds := make(map[string]bool)
+	for _, executionId := range data.Get("execution_ids").([]interface{}) {
+		managedExecutionIds[executionId.(string)] = true
+	}
+
+	var executionIds []string
+	for _, child := range children {
+		childId := keycloak.GetAuthenticationExecutionChildId(child)
+		if len(managedExecutionIds) == 0 || managedExecutionIds[childId] {
+			executionIds = append(executionIds, childId)
+		}
+	}
+
+	data.Set("execution_ids", executionIds)
+
+	return nil
+}
+
+func resourceKeycloakAuthenticationFlowOrderUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceKeycloakAuthenticationFlowOrderReconcile(ctx, data, meta)
+}
+
+func resourceKeycloakAuthenticationFlowOrderDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// the order of executions can't be removed, so executions are left where they are
+	return nil
+}
+
+func resourceKeycloakAuthenticationFlowOrderImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("Invalid import. Supported import formats: {{realmId}}/{{parentFlowAlias}}")
+	}
+
+	d.Set("realm_id", parts[0])
+	d.Set("parent_flow_alias", parts[1])
+
+	diagnostics := resourceKeycloakAuthenticationFlowOrderRead(ctx, d, meta)
+	if diagnostics.HasError() {
+		return nil, errors.New(diagnostics[0].Summary)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
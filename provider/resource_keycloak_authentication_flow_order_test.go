@@ -0,0 +1,157 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/charlesderek/terraform-w-keycloak/keycloak"
+)
+
+func TestAccKeycloakAuthenticationFlowOrder_basic(t *testing.T) {
+	t.Parallel()
+	flowAlias := acctest.RandomWithPrefix("tf-acc")
+	subFlowAlias := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakAuthenticationFlowDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakAuthenticationFlowOrder_basic(flowAlias, subFlowAlias, []string{"cookie", "subflow", "kerberos"}),
+				Check:  testAccCheckKeycloakAuthenticationFlowOrder("keycloak_authentication_flow_order.order", []string{"auth-cookie", subFlowAlias, "auth-spnego"}),
+			},
+			{
+				Config: testKeycloakAuthenticationFlowOrder_basic(flowAlias, subFlowAlias, []string{"kerberos", "cookie", "subflow"}),
+				Check:  testAccCheckKeycloakAuthenticationFlowOrder("keycloak_authentication_flow_order.order", []string{"auth-spnego", "auth-cookie", subFlowAlias}),
+			},
+			{
+				ResourceName:      "keycloak_authentication_flow_order.order",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccKeycloakAuthenticationFlowOrder_outOfBandReorder(t *testing.T) {
+	t.Parallel()
+	flowAlias := acctest.RandomWithPrefix("tf-acc")
+	subFlowAlias := acctest.RandomWithPrefix("tf-acc")
+
+	var children keycloak.AuthenticationExecutionList
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakAuthenticationFlowDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakAuthenticationFlowOrder_basic(flowAlias, subFlowAlias, []string{"cookie", "subflow", "kerberos"}),
+				Check: func(s *terraform.State) error {
+					var err error
+					children, err = keycloakClient.ListAuthenticationFlowChildren(testCtx, testAccRealm.Realm, flowAlias)
+
+					return err
+				},
+			},
+			{
+				PreConfig: func() {
+					err := keycloakClient.LowerAuthenticationExecutionPriority(testCtx, testAccRealm.Realm, children[0].Id)
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testKeycloakAuthenticationFlowOrder_basic(flowAlias, subFlowAlias, []string{"cookie", "subflow", "kerberos"}),
+				Check:  testAccCheckKeycloakAuthenticationFlowOrder("keycloak_authentication_flow_order.order", []string{"auth-cookie", subFlowAlias, "auth-spnego"}),
+			},
+		},
+	})
+}
+
+// testAccCheckKeycloakAuthenticationFlowOrder checks the order of the flow's children, using the authenticator for
+// executions and the alias for subflows
+func testAccCheckKeycloakAuthenticationFlowOrder(resourceName string, expectedOrder []string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		realmId := rs.Primary.Attributes["realm_id"]
+		parentFlowAlias := rs.Primary.Attributes["parent_flow_alias"]
+
+		children, err := keycloakClient.ListAuthenticationFlowChildren(testCtx, realmId, parentFlowAlias)
+		if err != nil {
+			return err
+		}
+
+		if len(children) != len(expectedOrder) {
+			return fmt.Errorf("expected flow %s to have %d children, got %d", parentFlowAlias, len(expectedOrder), len(children))
+		}
+
+		for i, child := range children {
+			name := child.ProviderId
+			if child.AuthenticationFlow {
+				name = child.DisplayName
+			}
+
+			if name != expectedOrder[i] {
+				return fmt.Errorf("expected child %d of flow %s to be %s, got %s", i, parentFlowAlias, expectedOrder[i], name)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakAuthenticationFlowOrder_basic(flowAlias, subFlowAlias string, order []string) string {
+	var executionIds string
+	for _, name := range order {
+		resourceType := "keycloak_authentication_execution"
+		if name == "subflow" {
+			resourceType = "keycloak_authentication_subflow"
+		}
+
+		executionIds += fmt.Sprintf("\n\t\t%s.%s.id,", resourceType, name)
+	}
+
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_authentication_flow" "flow" {
+	realm_id = data.keycloak_realm.realm.id
+	alias    = "%s"
+}
+
+resource "keycloak_authentication_execution" "kerberos" {
+	realm_id          = data.keycloak_realm.realm.id
+	parent_flow_alias = keycloak_authentication_flow.flow.alias
+	authenticator     = "auth-spnego"
+}
+
+resource "keycloak_authentication_subflow" "subflow" {
+	realm_id          = data.keycloak_realm.realm.id
+	parent_flow_alias = keycloak_authentication_flow.flow.alias
+	alias             = "%s"
+}
+
+resource "keycloak_authentication_execution" "cookie" {
+	realm_id          = data.keycloak_realm.realm.id
+	parent_flow_alias = keycloak_authentication_flow.flow.alias
+	authenticator     = "auth-cookie"
+}
+
+resource "keycloak_authentication_flow_order" "order" {
+	realm_id          = data.keycloak_realm.realm.id
+	parent_flow_alias = keycloak_authentication_flow.flow.alias
+	execution_ids     = [%s
+	]
+}
+	`, testAccRealm.Realm, flowAlias, subFlowAlias, executionIds)
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/charlesderek/terraform-w-keycloak/keycloak"
+	"regexp"
 	"testing"
 )
 
@@ -121,6 +122,54 @@ func TestAccKeycloakAuthenticationFlow_updateRealm(t *testing.T) {
 	})
 }
 
+func TestAccKeycloakAuthenticationFlow_copyFrom(t *testing.T) {
+	t.Parallel()
+	authFlowAlias := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakAuthenticationFlowDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakAuthenticationFlow_copyFrom(authFlowAlias, "browser"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakAuthenticationFlowExists("keycloak_authentication_flow.flow"),
+					resource.TestCheckResourceAttr("keycloak_authentication_flow.flow", "description", "copied from browser"),
+					resource.TestCheckTypeSetElemNestedAttrs("keycloak_authentication_flow.flow", "executions.*", map[string]string{
+						"parent_flow_alias": authFlowAlias,
+						"authenticator":     "auth-cookie",
+					}),
+				),
+			},
+			{
+				ResourceName:            "keycloak_authentication_flow.flow",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdPrefix:     testAccRealm.Realm + "/",
+				ImportStateVerifyIgnore: []string{"copy_from"},
+			},
+		},
+	})
+}
+
+func TestAccKeycloakAuthenticationFlow_copyFromMismatchedProviderId(t *testing.T) {
+	t.Parallel()
+	authFlowAlias := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakAuthenticationFlowDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakAuthenticationFlow_copyFrom(authFlowAlias, "clients"),
+				ExpectError: regexp.MustCompile("does not match the provider id of flow clients"),
+			},
+		},
+	})
+}
+
 func testAccCheckKeycloakAuthenticationFlowExists(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		_, err := getAuthenticationFlowFromState(s, resourceName)
@@ -211,6 +260,21 @@ resource "keycloak_authentication_flow" "flow" {
 	`, testAccRealm.Realm, alias)
 }
 
+func testKeycloakAuthenticationFlow_copyFrom(alias, copyFrom string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_authentication_flow" "flow" {
+	realm_id    = data.keycloak_realm.realm.id
+	alias       = "%s"
+	description = "copied from %s"
+	copy_from   = "%s"
+}
+	`, testAccRealm.Realm, alias, copyFrom, copyFrom)
+}
+
 func testKeycloakAuthenticationFlow_updateRealmBefore(alias string) string {
 	return fmt.Sprintf(`
 data "keycloak_realm" "realm_1" {
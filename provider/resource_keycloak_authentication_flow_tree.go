@@ -0,0 +1,711 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/charlesderek/terraform-w-keycloak/keycloak"
+	"sort"
+	"strings"
+)
+
+// terraform schemas can't be recursive, so subflows can only be nested up to this many levels deep.
+// this is enough for every flow that keycloak ships with.
+const authenticationFlowTreeMaxDepth = 5
+
+type authenticationFlowTreeExecution struct {
+	Id                  string
+	Authenticator       string
+	Requirement         string
+	AuthenticatorConfig *keycloak.AuthenticationExecutionConfig
+	SubFlow             *authenticationFlowTreeSubFlow
+}
+
+type authenticationFlowTreeSubFlow struct {
+	FlowId      string
+	Alias       string
+	ProviderId  string
+	Description string
+	Executions  []*authenticationFlowTreeExecution
+}
+
+func resourceKeycloakAuthenticationFlowTree() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKeycloakAuthenticationFlowTreeCreate,
+		ReadContext:   resourceKeycloakAuthenticationFlowTreeRead,
+		DeleteContext: resourceKeycloakAuthenticationFlowTreeDelete,
+		UpdateContext: resourceKeycloakAuthenticationFlowTreeUpdate,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceKeycloakAuthenticationFlowTreeImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"alias": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"provider_id": {
+				Type:         schema.TypeString,
+				Default:      "basic-flow",
+				ValidateFunc: validation.StringInSlice([]string{"basic-flow", "client-flow"}, false),
+				Optional:     true,
+				ForceNew:     true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"execution": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        authenticationFlowTreeExecutionSchema(1),
+				Description: "The executions and subflows of this flow, in the order they should run.",
+			},
+		},
+		CustomizeDiff: validateAuthenticationFlowTreeCustomizeDiff,
+	}
+}
+
+func authenticationFlowTreeExecutionSchema(depth int) *schema.Resource {
+	executionSchema := map[string]*schema.Schema{
+		"id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"authenticator": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The authenticator of the execution. Required unless this is a subflow.",
+		},
+		"requirement": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice([]string{"REQUIRED", "ALTERNATIVE", "OPTIONAL", "CONDITIONAL", "DISABLED"}, false),
+			Default:      "DISABLED",
+		},
+		"authenticator_config": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"alias": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"config": {
+						Type:     schema.TypeMap,
+						Elem:     &schema.Schema{Type: schema.TypeString},
+						Optional: true,
+					},
+				},
+			},
+		},
+	}
+
+	if depth < authenticationFlowTreeMaxDepth {
+		executionSchema["subflow"] = &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"flow_id": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"alias": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"provider_id": {
+						Type:         schema.TypeString,
+						Default:      "basic-flow",
+						ValidateFunc: validation.StringInSlice([]string{"basic-flow", "form-flow", "client-flow"}, false),
+						Optional:     true,
+					},
+					"description": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"execution": {
+						Type:     schema.TypeList,
+						Optional: true,
+						Elem:     authenticationFlowTreeExecutionSchema(depth + 1),
+					},
+				},
+			},
+		}
+	}
+
+	return &schema.Resource{
+		Schema: executionSchema,
+	}
+}
+
+func getAuthenticationFlowTreeExecutionsFromData(executionsData []interface{}) []*authenticationFlowTreeExecution {
+	var executions []*authenticationFlowTreeExecution
+
+	for _, executionData := range executionsData {
+		executionMap := executionData.(map[string]interface{})
+
+		execution := &authenticationFlowTreeExecution{
+			Id:            executionMap["id"].(string),
+			Authenticator: executionMap["authenticator"].(string),
+			Requirement:   executionMap["requirement"].(string),
+		}
+
+		if configData := executionMap["authenticator_config"].([]interface{}); len(configData) == 1 && configData[0] != nil {
+			configMap := configData[0].(map[string]interface{})
+
+			config := make(map[string]string)
+			for key, value := range configMap["config"].(map[string]interface{}) {
+				config[key] = value.(string)
+			}
+
+			execution.AuthenticatorConfig = &keycloak.AuthenticationExecutionConfig{
+				Id:     configMap["id"].(string),
+				Alias:  configMap["alias"].(string),
+				Config: config,
+			}
+		}
+
+		if subFlowData, ok := executionMap["subflow"].([]interface{}); ok && len(subFlowData) == 1 && subFlowData[0] != nil {
+			subFlowMap := subFlowData[0].(map[string]interface{})
+
+			execution.SubFlow = &authenticationFlowTreeSubFlow{
+				FlowId:      subFlowMap["flow_id"].(string),
+				Alias:       subFlowMap["alias"].(string),
+				ProviderId:  subFlowMap["provider_id"].(string),
+				Description: subFlowMap["description"].(string),
+				Executions:  getAuthenticationFlowTreeExecutionsFromData(subFlowMap["execution"].([]interface{})),
+			}
+		}
+
+		executions = append(executions, execution)
+	}
+
+	return executions
+}
+
+func getAuthenticationFlowTreeExecutionsData(executions []*authenticationFlowTreeExecution) []interface{} {
+	executionsData := make([]interface{}, 0, len(executions))
+
+	for _, execution := range executions {
+		executionData := map[string]interface{}{
+			"id":            execution.Id,
+			"authenticator": execution.Authenticator,
+			"requirement":   execution.Requirement,
+		}
+
+		if execution.AuthenticatorConfig != nil {
+			executionData["authenticator_config"] = []interface{}{
+				map[string]interface{}{
+					"id":     execution.AuthenticatorConfig.Id,
+					"alias":  execution.AuthenticatorConfig.Alias,
+					"config": execution.AuthenticatorConfig.Config,
+				},
+			}
+		}
+
+		if execution.SubFlow != nil {
+			executionData["subflow"] = []interface{}{
+				map[string]interface{}{
+					"flow_id":     execution.SubFlow.FlowId,
+					"alias":       execution.SubFlow.Alias,
+					"provider_id": execution.SubFlow.ProviderId,
+					"description": execution.SubFlow.Description,
+					"execution":   getAuthenticationFlowTreeExecutionsData(execution.SubFlow.Executions),
+				},
+			}
+		}
+
+		executionsData = append(executionsData, executionData)
+	}
+
+	return executionsData
+}
+
+// getAuthenticationFlowTreeSubFlowProviderIds returns the provider id of every subflow within the given executions, by
+// the id of the subflow
+func getAuthenticationFlowTreeSubFlowProviderIds(executions []*authenticationFlowTreeExecution, providerIds map[string]string) map[string]string {
+	for _, execution := range executions {
+		if execution.SubFlow == nil {
+			continue
+		}
+
+		if execution.SubFlow.FlowId != "" {
+			providerIds[execution.SubFlow.FlowId] = execution.SubFlow.ProviderId
+		}
+
+		getAuthenticationFlowTreeSubFlowProviderIds(execution.SubFlow.Executions, providerIds)
+	}
+
+	return providerIds
+}
+
+// getAuthenticationFlowTree builds the tree of executions within a flow from the depth-first list that keycloak returns
+// for the whole flow, using the level of each execution to find its parent and the index to order it within the parent.
+// the list doesn't include the type of subflows, and the type of a subflow can't be changed, so it is taken from
+// knownProviderIds when possible. only subflows that aren't known yet are fetched one by one.
+func getAuthenticationFlowTree(ctx context.Context, keycloakClient *keycloak.KeycloakClient, realmId, flowAlias string, knownProviderIds map[string]string) ([]*authenticationFlowTreeExecution, error) {
+	authenticationExecutions, err := keycloakClient.ListAuthenticationExecutions(ctx, realmId, flowAlias)
+	if err != nil {
+		return nil, err
+	}
+
+	root := &authenticationFlowTreeSubFlow{}
+	indexes := make(map[*authenticationFlowTreeExecution]int)
+
+	// parents[level] holds the subflow that executions at that level belong to
+	parents := []*authenticationFlowTreeSubFlow{root}
+
+	for _, authenticationExecution := range authenticationExecutions {
+		level := authenticationExecution.Level
+		if level >= len(parents) {
+			return nil, fmt.Errorf("unexpected nesting of execution %s in flow %s", authenticationExecution.Id, flowAlias)
+		}
+		if level >= authenticationFlowTreeMaxDepth {
+			return nil, fmt.Errorf("flow %s has subflows nested more than %d levels deep, which is not supported", flowAlias, authenticationFlowTreeMaxDepth-1)
+		}
+		parents = parents[:level+1]
+
+		execution := &authenticationFlowTreeExecution{
+			Id:            authenticationExecution.Id,
+			Authenticator: authenticationExecution.ProviderId,
+			Requirement:   authenticationExecution.Requirement,
+		}
+		indexes[execution] = authenticationExecution.Index
+
+		if authenticationExecution.AuthenticationConfig != "" {
+			config := &keycloak.AuthenticationExecutionConfig{
+				RealmId: realmId,
+				Id:      authenticationExecution.AuthenticationConfig,
+			}
+
+			err = keycloakClient.GetAuthenticationExecutionConfig(ctx, config)
+			if err != nil {
+				return nil, err
+			}
+
+			execution.AuthenticatorConfig = config
+		}
+
+		if authenticationExecution.AuthenticationFlow {
+			providerId, ok := knownProviderIds[authenticationExecution.FlowId]
+			if !ok {
+				subFlow, err := keycloakClient.GetAuthenticationFlow(ctx, realmId, authenticationExecution.FlowId)
+				if err != nil {
+					return nil, err
+				}
+
+				providerId = subFlow.ProviderId
+			}
+
+			execution.SubFlow = &authenticationFlowTreeSubFlow{
+				FlowId:      authenticationExecution.FlowId,
+				Alias:       authenticationExecution.DisplayName,
+				ProviderId:  providerId,
+				Description: authenticationExecution.Description,
+			}
+		}
+
+		parent := parents[level]
+		parent.Executions = append(parent.Executions, execution)
+
+		if execution.SubFlow != nil {
+			parents = append(parents, execution.SubFlow)
+		}
+	}
+
+	sortAuthenticationFlowTreeExecutions(root.Executions, indexes)
+
+	return root.Executions, nil
+}
+
+func sortAuthenticationFlowTreeExecutions(executions []*authenticationFlowTreeExecution, indexes map[*authenticationFlowTreeExecution]int) {
+	sort.SliceStable(executions, func(i, j int) bool {
+		return indexes[executions[i]] < indexes[executions[j]]
+	})
+
+	for _, execution := range executions {
+		if execution.SubFlow != nil {
+			sortAuthenticationFlowTreeExecutions(execution.SubFlow.Executions, indexes)
+		}
+	}
+}
+
+// authenticationFlowTreeExecutionKey is used to match executions in the configuration with executions in keycloak.
+// executions that can't be changed in place, such as subflows with a different type, get a different key so they are recreated.
+func authenticationFlowTreeExecutionKey(execution *authenticationFlowTreeExecution) string {
+	if execution.SubFlow != nil {
+		return fmt.Sprintf("subflow/%s/%s/%s", execution.SubFlow.Alias, execution.SubFlow.ProviderId, execution.Authenticator)
+	}
+
+	return fmt.Sprintf("execution/%s", execution.Authenticator)
+}
+
+// matchAuthenticationFlowTree matches the desired executions with the executions in keycloak, so executions that exist
+// in both can be updated in place. the matches are stored by desired execution, and the executions in keycloak that
+// aren't matched anywhere in the tree are returned, so they can be removed before anything is created.
+func matchAuthenticationFlowTree(flowAlias string, actual, desired []*authenticationFlowTreeExecution, matches map[*authenticationFlowTreeExecution]*authenticationFlowTreeExecution) ([]*authenticationFlowTreeExecution, error) {
+	var unmatched []*authenticationFlowTreeExecution
+	isMatched := make(map[*authenticationFlowTreeExecution]bool)
+
+	for _, desiredExecution := range desired {
+		if desiredExecution.SubFlow == nil && desiredExecution.Authenticator == "" {
+			return nil, fmt.Errorf("executions in flow %s must either set an authenticator or be a subflow", flowAlias)
+		}
+
+		var match *authenticationFlowTreeExecution
+		for _, actualExecution := range actual {
+			if !isMatched[actualExecution] && authenticationFlowTreeExecutionKey(actualExecution) == authenticationFlowTreeExecutionKey(desiredExecution) {
+				match = actualExecution
+				isMatched[actualExecution] = true
+				matches[desiredExecution] = actualExecution
+				break
+			}
+		}
+
+		if desiredExecution.SubFlow == nil {
+			continue
+		}
+
+		// the executions of a subflow that is recreated are removed along with it, so they aren't matched
+		var actualChildren []*authenticationFlowTreeExecution
+		if match != nil {
+			actualChildren = match.SubFlow.Executions
+		}
+
+		childrenUnmatched, err := matchAuthenticationFlowTree(desiredExecution.SubFlow.Alias, actualChildren, desiredExecution.SubFlow.Executions, matches)
+		if err != nil {
+			return nil, err
+		}
+
+		unmatched = append(unmatched, childrenUnmatched...)
+	}
+
+	for _, actualExecution := range actual {
+		if !isMatched[actualExecution] {
+			unmatched = append(unmatched, actualExecution)
+		}
+	}
+
+	return unmatched, nil
+}
+
+// reconcileAuthenticationFlowTree makes the given flow match the desired executions, using as few operations as
+// possible: executions that exist in both are updated in place, and only the remaining ones are added or removed.
+// executions are removed from the whole tree first, so subflows that are recreated or moved to another parent don't
+// conflict with their old alias.
+func reconcileAuthenticationFlowTree(ctx context.Context, keycloakClient *keycloak.KeycloakClient, realmId, flowAlias string, actual, desired []*authenticationFlowTreeExecution) error {
+	matches := make(map[*authenticationFlowTreeExecution]*authenticationFlowTreeExecution)
+
+	unmatched, err := matchAuthenticationFlowTree(flowAlias, actual, desired, matches)
+	if err != nil {
+		return err
+	}
+
+	for _, actualExecution := range unmatched {
+		err = keycloakClient.DeleteAuthenticationExecution(ctx, realmId, actualExecution.Id)
+		if err != nil {
+			return err
+		}
+	}
+
+	return applyAuthenticationFlowTree(ctx, keycloakClient, realmId, flowAlias, desired, matches)
+}
+
+// applyAuthenticationFlowTree creates or updates the desired executions of a flow, and puts them in order
+func applyAuthenticationFlowTree(ctx context.Context, keycloakClient *keycloak.KeycloakClient, realmId, flowAlias string, desired []*authenticationFlowTreeExecution, matches map[*authenticationFlowTreeExecution]*authenticationFlowTreeExecution) error {
+	var order []string
+	for _, desiredExecution := range desired {
+		var err error
+
+		actualExecution, ok := matches[desiredExecution]
+		if !ok {
+			actualExecution, err = newAuthenticationFlowTreeExecution(ctx, keycloakClient, realmId, flowAlias, desiredExecution)
+		} else {
+			err = updateAuthenticationFlowTreeExecution(ctx, keycloakClient, realmId, flowAlias, actualExecution, desiredExecution)
+		}
+		if err != nil {
+			return err
+		}
+
+		err = reconcileAuthenticationFlowTreeConfig(ctx, keycloakClient, realmId, actualExecution, desiredExecution)
+		if err != nil {
+			return err
+		}
+
+		if desiredExecution.SubFlow != nil {
+			order = append(order, actualExecution.SubFlow.FlowId)
+
+			err = applyAuthenticationFlowTree(ctx, keycloakClient, realmId, desiredExecution.SubFlow.Alias, desiredExecution.SubFlow.Executions, matches)
+			if err != nil {
+				return err
+			}
+		} else {
+			order = append(order, actualExecution.Id)
+		}
+	}
+
+	if len(order) == 0 {
+		return nil
+	}
+
+	return keycloakClient.ReorderAuthenticationFlowChildren(ctx, realmId, flowAlias, order)
+}
+
+func mapFromAuthenticationFlowTreeExecutionToSubFlow(realmId, flowAlias string, execution *authenticationFlowTreeExecution) *keycloak.AuthenticationSubFlow {
+	return &keycloak.AuthenticationSubFlow{
+		RealmId:         realmId,
+		ParentFlowAlias: flowAlias,
+		Alias:           execution.SubFlow.Alias,
+		ProviderId:      execution.SubFlow.ProviderId,
+		Description:     execution.SubFlow.Description,
+		Authenticator:   execution.Authenticator,
+		Requirement:     execution.Requirement,
+	}
+}
+
+// newAuthenticationFlowTreeExecution creates the execution or subflow, and returns it as it now exists in keycloak
+func newAuthenticationFlowTreeExecution(ctx context.Context, keycloakClient *keycloak.KeycloakClient, realmId, flowAlias string, desired *authenticationFlowTreeExecution) (*authenticationFlowTreeExecution, error) {
+	if desired.SubFlow != nil {
+		subFlow := mapFromAuthenticationFlowTreeExecutionToSubFlow(realmId, flowAlias, desired)
+
+		err := keycloakClient.NewAuthenticationSubFlow(ctx, subFlow)
+		if err != nil {
+			return nil, err
+		}
+
+		subFlowExecution, err := keycloakClient.GetAuthenticationSubFlowExecutionInfoFromAlias(ctx, realmId, flowAlias, subFlow.Alias)
+		if err != nil {
+			return nil, err
+		}
+
+		return &authenticationFlowTreeExecution{
+			Id: subFlowExecution.Id,
+			SubFlow: &authenticationFlowTreeSubFlow{
+				FlowId: subFlow.Id,
+			},
+		}, nil
+	}
+
+	execution := &keycloak.AuthenticationExecution{
+		RealmId:         realmId,
+		ParentFlowAlias: flowAlias,
+		Authenticator:   desired.Authenticator,
+		Requirement:     desired.Requirement,
+	}
+
+	err := keycloakClient.NewAuthenticationExecution(ctx, execution)
+	if err != nil {
+		return nil, err
+	}
+
+	return &authenticationFlowTreeExecution{
+		Id: execution.Id,
+	}, nil
+}
+
+func updateAuthenticationFlowTreeExecution(ctx context.Context, keycloakClient *keycloak.KeycloakClient, realmId, flowAlias string, actual, desired *authenticationFlowTreeExecution) error {
+	if desired.SubFlow != nil {
+		if actual.Requirement == desired.Requirement && actual.SubFlow.Description == desired.SubFlow.Description {
+			return nil
+		}
+
+		subFlow := mapFromAuthenticationFlowTreeExecutionToSubFlow(realmId, flowAlias, desired)
+		subFlow.Id = actual.SubFlow.FlowId
+
+		return keycloakClient.UpdateAuthenticationSubFlow(ctx, subFlow)
+	}
+
+	if actual.Requirement == desired.Requirement {
+		return nil
+	}
+
+	return keycloakClient.UpdateAuthenticationExecution(ctx, &keycloak.AuthenticationExecution{
+		Id:              actual.Id,
+		RealmId:         realmId,
+		ParentFlowAlias: flowAlias,
+		Requirement:     desired.Requirement,
+	})
+}
+
+func reconcileAuthenticationFlowTreeConfig(ctx context.Context, keycloakClient *keycloak.KeycloakClient, realmId string, actualExecution, desiredExecution *authenticationFlowTreeExecution) error {
+	actualConfig := actualExecution.AuthenticatorConfig
+	desiredConfig := desiredExecution.AuthenticatorConfig
+
+	if desiredConfig == nil {
+		if actualConfig == nil {
+			return nil
+		}
+
+		return keycloakClient.DeleteAuthenticationExecutionConfig(ctx, actualConfig)
+	}
+
+	config := &keycloak.AuthenticationExecutionConfig{
+		RealmId:     realmId,
+		ExecutionId: actualExecution.Id,
+		Alias:       desiredConfig.Alias,
+		Config:      desiredConfig.Config,
+	}
+
+	if actualConfig == nil {
+		_, err := keycloakClient.NewAuthenticationExecutionConfig(ctx, config)
+		return err
+	}
+
+	if actualConfig.Alias == desiredConfig.Alias && stringMapsEqual(actualConfig.Config, desiredConfig.Config) {
+		return nil
+	}
+
+	config.Id = actualConfig.Id
+
+	return keycloakClient.UpdateAuthenticationExecutionConfig(ctx, config)
+}
+
+func stringMapsEqual(a, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for key, value := range a {
+		if otherValue, ok := b[key]; !ok || otherValue != value {
+			return false
+		}
+	}
+
+	return true
+}
+
+func resourceKeycloakAuthenticationFlowTreeReconcile(ctx context.Context, keycloakClient *keycloak.KeycloakClient, data *schema.ResourceData, authenticationFlow *keycloak.AuthenticationFlow) error {
+	// the types of the subflows are taken from the state, since the configuration may change them
+	oldExecutions, _ := data.GetChange("execution")
+	knownProviderIds := getAuthenticationFlowTreeSubFlowProviderIds(getAuthenticationFlowTreeExecutionsFromData(oldExecutions.([]interface{})), map[string]string{})
+
+	actual, err := getAuthenticationFlowTree(ctx, keycloakClient, authenticationFlow.RealmId, authenticationFlow.Alias, knownProviderIds)
+	if err != nil {
+		return err
+	}
+
+	desired := getAuthenticationFlowTreeExecutionsFromData(data.Get("execution").([]interface{}))
+
+	return reconcileAuthenticationFlowTree(ctx, keycloakClient, authenticationFlow.RealmId, authenticationFlow.Alias, actual, desired)
+}
+
+func resourceKeycloakAuthenticationFlowTreeCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	authenticationFlow := mapFromDataToAuthenticationFlow(data)
+
+	err := keycloakClient.NewAuthenticationFlow(ctx, authenticationFlow)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(authenticationFlow.Id)
+
+	err = resourceKeycloakAuthenticationFlowTreeReconcile(ctx, keycloakClient, data, authenticationFlow)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceKeycloakAuthenticationFlowTreeRead(ctx, data, meta)
+}
+
+func resourceKeycloakAuthenticationFlowTreeRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	id := data.Id()
+
+	authenticationFlow, err := keycloakClient.GetAuthenticationFlow(ctx, realmId, id)
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	knownProviderIds := getAuthenticationFlowTreeSubFlowProviderIds(getAuthenticationFlowTreeExecutionsFromData(data.Get("execution").([]interface{})), map[string]string{})
+
+	executions, err := getAuthenticationFlowTree(ctx, keycloakClient, realmId, authenticationFlow.Alias, knownProviderIds)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	mapFromAuthenticationFlowToData(data, authenticationFlow)
+	data.Set("execution", getAuthenticationFlowTreeExecutionsData(executions))
+
+	return nil
+}
+
+func resourceKeycloakAuthenticationFlowTreeUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	authenticationFlow := mapFromDataToAuthenticationFlow(data)
+
+	if data.HasChanges("alias", "description") {
+		err := keycloakClient.UpdateAuthenticationFlow(ctx, authenticationFlow)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	err := resourceKeycloakAuthenticationFlowTreeReconcile(ctx, keycloakClient, data, authenticationFlow)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceKeycloakAuthenticationFlowTreeRead(ctx, data, meta)
+}
+
+func resourceKeycloakAuthenticationFlowTreeDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	id := data.Id()
+
+	return diag.FromErr(keycloakClient.DeleteAuthenticationFlow(ctx, realmId, id))
+}
+
+func resourceKeycloakAuthenticationFlowTreeImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	parts := strings.Split(d.Id(), "/")
+
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("Invalid import. Supported import formats: {{realmId}}/{{authenticationFlowId}}, {{realmId}}/{{authenticationFlowAlias}}")
+	}
+
+	authenticationFlow, err := keycloakClient.GetAuthenticationFlow(ctx, parts[0], parts[1])
+	if err != nil {
+		if !keycloak.ErrorIs404(err) {
+			return nil, err
+		}
+
+		authenticationFlow, err = keycloakClient.GetAuthenticationFlowFromAlias(ctx, parts[0], parts[1])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	d.Set("realm_id", parts[0])
+	d.SetId(authenticationFlow.Id)
+
+	diagnostics := resourceKeycloakAuthenticationFlowTreeRead(ctx, d, meta)
+	if diagnostics.HasError() {
+		return nil, errors.New(diagnostics[0].Summary)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,274 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/charlesderek/terraform-w-keycloak/keycloak"
+)
+
+func TestAccKeycloakAuthenticationFlowTree_basic(t *testing.T) {
+	t.Parallel()
+	flowAlias := acctest.RandomWithPrefix("tf-acc")
+	subFlowAlias := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakAuthenticationFlowTreeDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakAuthenticationFlowTree_basic(flowAlias, subFlowAlias),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("keycloak_authentication_flow_tree.flow", "execution.#", "3"),
+					resource.TestCheckResourceAttr("keycloak_authentication_flow_tree.flow", "execution.0.authenticator", "auth-cookie"),
+					resource.TestCheckResourceAttr("keycloak_authentication_flow_tree.flow", "execution.1.authenticator", "identity-provider-redirector"),
+					resource.TestCheckResourceAttr("keycloak_authentication_flow_tree.flow", "execution.1.authenticator_config.0.config.defaultProvider", "my-idp"),
+					resource.TestCheckResourceAttr("keycloak_authentication_flow_tree.flow", "execution.2.subflow.0.alias", subFlowAlias),
+					resource.TestCheckResourceAttr("keycloak_authentication_flow_tree.flow", "execution.2.subflow.0.execution.#", "2"),
+				),
+			},
+			{
+				ResourceName:      "keycloak_authentication_flow_tree.flow",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     fmt.Sprintf("%s/%s", testAccRealm.Realm, flowAlias),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakAuthenticationFlowTree_update(t *testing.T) {
+	t.Parallel()
+	flowAlias := acctest.RandomWithPrefix("tf-acc")
+	subFlowAlias := acctest.RandomWithPrefix("tf-acc")
+
+	var cookieExecutionId string
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakAuthenticationFlowTreeDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakAuthenticationFlowTree_basic(flowAlias, subFlowAlias),
+				Check: func(s *terraform.State) error {
+					cookieExecutionId = s.RootModule().Resources["keycloak_authentication_flow_tree.flow"].Primary.Attributes["execution.0.id"]
+
+					return nil
+				},
+			},
+			{
+				Config: testKeycloakAuthenticationFlowTree_updated(flowAlias, subFlowAlias),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("keycloak_authentication_flow_tree.flow", "execution.#", "2"),
+					resource.TestCheckResourceAttr("keycloak_authentication_flow_tree.flow", "execution.0.subflow.0.alias", subFlowAlias),
+					resource.TestCheckResourceAttr("keycloak_authentication_flow_tree.flow", "execution.0.subflow.0.execution.0.requirement", "REQUIRED"),
+					resource.TestCheckResourceAttr("keycloak_authentication_flow_tree.flow", "execution.1.authenticator", "auth-cookie"),
+					resource.TestCheckResourceAttr("keycloak_authentication_flow_tree.flow", "execution.1.requirement", "REQUIRED"),
+					// the cookie execution should have been moved and updated in place, rather than recreated
+					func(s *terraform.State) error {
+						id := s.RootModule().Resources["keycloak_authentication_flow_tree.flow"].Primary.Attributes["execution.1.id"]
+						if id != cookieExecutionId {
+							return fmt.Errorf("expected execution %s to be updated in place, but it was recreated as %s", cookieExecutionId, id)
+						}
+
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakAuthenticationFlowTree_moveSubFlow(t *testing.T) {
+	t.Parallel()
+	flowAlias := acctest.RandomWithPrefix("tf-acc")
+	subFlowAlias := acctest.RandomWithPrefix("tf-acc")
+	nestedSubFlowAlias := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakAuthenticationFlowTreeDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakAuthenticationFlowTree_nestedSubFlow(flowAlias, subFlowAlias, nestedSubFlowAlias, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("keycloak_authentication_flow_tree.flow", "execution.#", "1"),
+					resource.TestCheckResourceAttr("keycloak_authentication_flow_tree.flow", "execution.0.subflow.0.execution.0.subflow.0.alias", nestedSubFlowAlias),
+				),
+			},
+			{
+				Config: testKeycloakAuthenticationFlowTree_nestedSubFlow(flowAlias, subFlowAlias, nestedSubFlowAlias, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("keycloak_authentication_flow_tree.flow", "execution.#", "2"),
+					resource.TestCheckResourceAttr("keycloak_authentication_flow_tree.flow", "execution.0.subflow.0.execution.#", "1"),
+					resource.TestCheckResourceAttr("keycloak_authentication_flow_tree.flow", "execution.1.subflow.0.alias", nestedSubFlowAlias),
+					resource.TestCheckResourceAttr("keycloak_authentication_flow_tree.flow", "execution.1.subflow.0.execution.0.authenticator", "auth-otp-form"),
+				),
+			},
+			{
+				ResourceName:      "keycloak_authentication_flow_tree.flow",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     fmt.Sprintf("%s/%s", testAccRealm.Realm, flowAlias),
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakAuthenticationFlowTreeDestroy() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "keycloak_authentication_flow_tree" {
+				continue
+			}
+
+			id := rs.Primary.ID
+			realm := rs.Primary.Attributes["realm_id"]
+
+			authenticationFlow, err := keycloakClient.GetAuthenticationFlow(testCtx, realm, id)
+			if err == nil && authenticationFlow != nil {
+				return fmt.Errorf("authentication flow with id %s still exists", id)
+			} else if err != nil && !keycloak.ErrorIs404(err) {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakAuthenticationFlowTree_basic(flowAlias, subFlowAlias string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_authentication_flow_tree" "flow" {
+	realm_id = data.keycloak_realm.realm.id
+	alias    = "%s"
+
+	execution {
+		authenticator = "auth-cookie"
+		requirement   = "ALTERNATIVE"
+	}
+
+	execution {
+		authenticator = "identity-provider-redirector"
+		requirement   = "ALTERNATIVE"
+
+		authenticator_config {
+			alias  = "%s-idp"
+			config = {
+				defaultProvider = "my-idp"
+			}
+		}
+	}
+
+	execution {
+		requirement = "ALTERNATIVE"
+
+		subflow {
+			alias = "%s"
+
+			execution {
+				authenticator = "auth-username-password-form"
+				requirement   = "REQUIRED"
+			}
+
+			execution {
+				authenticator = "auth-otp-form"
+				requirement   = "ALTERNATIVE"
+			}
+		}
+	}
+}
+	`, testAccRealm.Realm, flowAlias, flowAlias, subFlowAlias)
+}
+
+func testKeycloakAuthenticationFlowTree_updated(flowAlias, subFlowAlias string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_authentication_flow_tree" "flow" {
+	realm_id = data.keycloak_realm.realm.id
+	alias    = "%s"
+
+	execution {
+		requirement = "ALTERNATIVE"
+
+		subflow {
+			alias       = "%s"
+			description = "username and password"
+
+			execution {
+				authenticator = "auth-username-password-form"
+				requirement   = "REQUIRED"
+			}
+		}
+	}
+
+	execution {
+		authenticator = "auth-cookie"
+		requirement   = "REQUIRED"
+	}
+}
+	`, testAccRealm.Realm, flowAlias, subFlowAlias)
+}
+
+func testKeycloakAuthenticationFlowTree_nestedSubFlow(flowAlias, subFlowAlias, nestedSubFlowAlias string, nested bool) string {
+	nestedSubFlow := fmt.Sprintf(`
+	execution {
+		requirement = "ALTERNATIVE"
+
+		subflow {
+			alias = "%s"
+
+			execution {
+				authenticator = "auth-otp-form"
+				requirement   = "REQUIRED"
+			}
+		}
+	}
+	`, nestedSubFlowAlias)
+
+	subFlowExecutions, flowExecutions := nestedSubFlow, ""
+	if !nested {
+		subFlowExecutions, flowExecutions = "", nestedSubFlow
+	}
+
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_authentication_flow_tree" "flow" {
+	realm_id = data.keycloak_realm.realm.id
+	alias    = "%s"
+
+	execution {
+		requirement = "ALTERNATIVE"
+
+		subflow {
+			alias = "%s"
+
+			execution {
+				authenticator = "auth-username-password-form"
+				requirement   = "REQUIRED"
+			}
+
+			%s
+		}
+	}
+
+	%s
+}
+	`, testAccRealm.Realm, flowAlias, subFlowAlias, subFlowExecutions, flowExecutions)
+}
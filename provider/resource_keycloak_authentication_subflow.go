@@ -60,6 +60,7 @@ func resourceKeycloakAuthenticationSubFlow() *schema.Resource {
 				Default:      "DISABLED",
 			},
 		},
+		CustomizeDiff: validateAuthenticatorCustomizeDiff,
 	}
 }
 
@@ -146,17 +147,28 @@ func resourceKeycloakAuthenticationSubFlowImport(ctx context.Context, d *schema.
 	parts := strings.Split(d.Id(), "/")
 
 	if len(parts) != 3 {
-		return nil, fmt.Errorf("Invalid import. Supported import formats: {{realmId}}/{{parentFlowAlias}}/{{authenticationSubFlowId}}")
+		return nil, fmt.Errorf("Invalid import. Supported import formats: {{realmId}}/{{parentFlowAlias}}/{{authenticationSubFlowId}}, {{realmId}}/{{parentFlowAlias}}/{{authenticationSubFlowAlias}}")
 	}
 
-	_, err := keycloakClient.GetAuthenticationSubFlow(ctx, parts[0], parts[1], parts[2])
+	id := parts[2]
+
+	_, err := keycloakClient.GetAuthenticationSubFlow(ctx, parts[0], parts[1], id)
 	if err != nil {
-		return nil, err
+		if !keycloak.ErrorIs404(err) {
+			return nil, err
+		}
+
+		// subflows created by copying a flow can also be imported using their alias
+		subFlowExecution, err := keycloakClient.GetAuthenticationSubFlowExecutionInfoFromAlias(ctx, parts[0], parts[1], id)
+		if err != nil {
+			return nil, err
+		}
+		id = subFlowExecution.FlowId
 	}
 
 	d.Set("realm_id", parts[0])
 	d.Set("parent_flow_alias", parts[1])
-	d.SetId(parts[2])
+	d.SetId(id)
 
 	diagnostics := resourceKeycloakAuthenticationSubFlowRead(ctx, d, meta)
 	if diagnostics.HasError() {
@@ -30,6 +30,12 @@ func TestAccKeycloakAuthenticationSubFlow_basic(t *testing.T) {
 				ImportStateVerify: true,
 				ImportStateIdFunc: getSubFlowImportId("keycloak_authentication_subflow.subflow"),
 			},
+			{
+				ResourceName:      "keycloak_authentication_subflow.subflow",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: getSubFlowImportIdFromAlias("keycloak_authentication_subflow.subflow"),
+			},
 		},
 	})
 }
@@ -216,6 +222,21 @@ func getSubFlowImportId(resourceName string) resource.ImportStateIdFunc {
 	}
 }
 
+func getSubFlowImportIdFromAlias(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		alias := rs.Primary.Attributes["alias"]
+		parentFlowAlias := rs.Primary.Attributes["parent_flow_alias"]
+		realmId := rs.Primary.Attributes["realm_id"]
+
+		return fmt.Sprintf("%s/%s/%s", realmId, parentFlowAlias, alias), nil
+	}
+}
+
 func testKeycloakAuthenticationSubFlow_basic(parentAlias, alias string) string {
 	return fmt.Sprintf(`
 data "keycloak_realm" "realm" {
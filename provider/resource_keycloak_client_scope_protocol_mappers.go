@@ -0,0 +1,19 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceKeycloakClientScopeProtocolMappers() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKeycloakGenericProtocolMappersReconcile("client_scope_id"),
+		ReadContext:   resourceKeycloakGenericProtocolMappersRead("client_scope_id"),
+		UpdateContext: resourceKeycloakGenericProtocolMappersReconcile("client_scope_id"),
+		DeleteContext: resourceKeycloakGenericProtocolMappersDelete("client_scope_id"),
+		// This resource can be imported using {{realmId}}/{{clientScopeId}}
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceKeycloakGenericProtocolMappersImport("client_scope_id", "{{realmId}}/{{clientScopeId}}"),
+		},
+		Schema: genericProtocolMappersSchema("client_scope_id", "Id of the openid or saml client scope that owns the protocol mappers"),
+	}
+}
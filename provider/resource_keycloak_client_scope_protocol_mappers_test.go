@@ -0,0 +1,76 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccKeycloakClientScopeProtocolMappers_basic(t *testing.T) {
+	t.Parallel()
+
+	clientScopeName := acctest.RandomWithPrefix("tf-acc")
+	resourceName := "keycloak_client_scope_protocol_mappers.mappers"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakClientScopeDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakClientScopeProtocolMappers(clientScopeName, "oidc-hardcoded-claim-mapper"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakProtocolMappersCount(resourceName, "client_scope_id", 1),
+					resource.TestCheckResourceAttr(resourceName, "mapper.#", "1"),
+				),
+			},
+			// changing the type of a mapper replaces it
+			{
+				Config: testKeycloakClientScopeProtocolMappers(clientScopeName, "oidc-usersessionmodel-note-mapper"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakProtocolMappersCount(resourceName, "client_scope_id", 1),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "mapper.*", map[string]string{
+						"protocol_mapper": "oidc-usersessionmodel-note-mapper",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testKeycloakClientScopeProtocolMappers(clientScopeName, protocolMapper string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client_scope" "client_scope" {
+	name     = "%s"
+	realm_id = data.keycloak_realm.realm.id
+}
+
+resource "keycloak_client_scope_protocol_mappers" "mappers" {
+	realm_id        = data.keycloak_realm.realm.id
+	client_scope_id = keycloak_openid_client_scope.client_scope.id
+
+	mapper {
+		name            = "tenant"
+		protocol_mapper = "%s"
+		config = {
+			"claim.name"         = "tenant"
+			"claim.value"        = "acme"
+			"user.session.note"  = "tenant"
+			"jsonType.label"     = "String"
+			"access.token.claim" = "true"
+		}
+	}
+}
+	`, testAccRealm.Realm, clientScopeName, protocolMapper)
+}
@@ -0,0 +1,19 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceKeycloakClientScopeRoleMappings() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKeycloakGenericRoleScopeMappingsReconcile("client_scope_id"),
+		ReadContext:   resourceKeycloakGenericRoleScopeMappingsRead("client_scope_id"),
+		UpdateContext: resourceKeycloakGenericRoleScopeMappingsReconcile("client_scope_id"),
+		DeleteContext: resourceKeycloakGenericRoleScopeMappingsDelete("client_scope_id"),
+		// This resource can be imported using {{realmId}}/{{clientScopeId}}
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceKeycloakGenericRoleScopeMappingsImport("client_scope_id", "{{realmId}}/{{clientScopeId}}"),
+		},
+		Schema: genericRoleScopeMappingsSchema("client_scope_id", "Id of the openid or saml client scope the roles are mapped into"),
+	}
+}
@@ -0,0 +1,196 @@
+package provider
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/charlesderek/terraform-w-keycloak/keycloak"
+)
+
+func TestAccKeycloakClientScopeRoleMappings_basic(t *testing.T) {
+	t.Parallel()
+
+	clientScopeName := acctest.RandomWithPrefix("tf-acc")
+	clientId := acctest.RandomWithPrefix("tf-acc")
+	realmRoleName := acctest.RandomWithPrefix("tf-acc")
+	clientRoleName := acctest.RandomWithPrefix("tf-acc")
+
+	resourceName := "keycloak_client_scope_role_mappings.mappings"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakClientScopeDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakClientScopeRoleMappings(clientScopeName, clientId, realmRoleName, clientRoleName, "keycloak_role.realm_role.id"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakRoleScopeMappingsMatchState(resourceName, "client_scope_id"),
+					resource.TestCheckResourceAttr(resourceName, "role_ids.#", "1"),
+				),
+			},
+			{
+				Config: testKeycloakClientScopeRoleMappings(clientScopeName, clientId, realmRoleName, clientRoleName, "keycloak_role.realm_role.id, keycloak_role.client_role.id"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakRoleScopeMappingsMatchState(resourceName, "client_scope_id"),
+					resource.TestCheckResourceAttr(resourceName, "role_ids.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testKeycloakClientScopeRoleMappings(clientScopeName, clientId, realmRoleName, clientRoleName, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakRoleScopeMappingsMatchState(resourceName, "client_scope_id"),
+					resource.TestCheckResourceAttr(resourceName, "role_ids.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakClientScopeRoleMappings_removesRolesMappedOutOfBand(t *testing.T) {
+	t.Parallel()
+
+	clientScopeName := acctest.RandomWithPrefix("tf-acc")
+	clientId := acctest.RandomWithPrefix("tf-acc")
+	realmRoleName := acctest.RandomWithPrefix("tf-acc")
+	clientRoleName := acctest.RandomWithPrefix("tf-acc")
+
+	resourceName := "keycloak_client_scope_role_mappings.mappings"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakClientScopeDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakClientScopeRoleMappings(clientScopeName, clientId, realmRoleName, clientRoleName, "keycloak_role.realm_role.id"),
+				Check:  testAccCheckKeycloakRoleScopeMappingsMatchState(resourceName, "client_scope_id"),
+			},
+			{
+				PreConfig: func() {
+					clientScopes, err := keycloakClient.ListOpenidClientScopesWithFilter(testCtx, testAccRealm.Realm, keycloak.IncludeOpenidClientScopesMatchingNames([]string{clientScopeName}))
+					if err != nil || len(clientScopes) != 1 {
+						t.Fatalf("unable to find client scope %s: %v", clientScopeName, err)
+					}
+
+					client, err := keycloakClient.GetGenericClientByClientId(testCtx, testAccRealm.Realm, clientId)
+					if err != nil {
+						t.Fatal(err)
+					}
+
+					role, err := keycloakClient.GetRoleByName(testCtx, testAccRealm.Realm, client.Id, clientRoleName)
+					if err != nil {
+						t.Fatal(err)
+					}
+
+					err = keycloakClient.CreateRoleScopeMapping(testCtx, testAccRealm.Realm, "", clientScopes[0].Id, role)
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testKeycloakClientScopeRoleMappings(clientScopeName, clientId, realmRoleName, clientRoleName, "keycloak_role.realm_role.id"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakRoleScopeMappingsMatchState(resourceName, "client_scope_id"),
+					resource.TestCheckResourceAttr(resourceName, "role_ids.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckKeycloakRoleScopeMappingsMatchState checks that exactly the roles in state are mapped into the scope of
+// the client or client scope
+func testAccCheckKeycloakRoleScopeMappingsMatchState(resourceName, parentAttribute string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		realmId := rs.Primary.Attributes["realm_id"]
+		parentId := rs.Primary.Attributes[parentAttribute]
+
+		var roleMappings *keycloak.RoleMapping
+		var err error
+		if parentAttribute == "client_id" {
+			roleMappings, err = keycloakClient.GetRoleScopeMappings(testCtx, realmId, parentId, "")
+		} else {
+			roleMappings, err = keycloakClient.GetRoleScopeMappings(testCtx, realmId, "", parentId)
+		}
+		if err != nil {
+			return err
+		}
+
+		var mappedRoleIds []string
+		for _, role := range roleMappings.RealmMappings {
+			mappedRoleIds = append(mappedRoleIds, role.Id)
+		}
+		for _, clientMapping := range roleMappings.ClientMappings {
+			for _, role := range clientMapping.Mappings {
+				mappedRoleIds = append(mappedRoleIds, role.Id)
+			}
+		}
+
+		var stateRoleIds []string
+		for key, value := range rs.Primary.Attributes {
+			if strings.HasPrefix(key, "role_ids.") && key != "role_ids.#" {
+				stateRoleIds = append(stateRoleIds, value)
+			}
+		}
+
+		sort.Strings(mappedRoleIds)
+		sort.Strings(stateRoleIds)
+
+		if strings.Join(mappedRoleIds, ",") != strings.Join(stateRoleIds, ",") {
+			return fmt.Errorf("expected the roles %v to be mapped into %s, got %v", stateRoleIds, parentId, mappedRoleIds)
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakClientScopeRoleMappings(clientScopeName, clientId, realmRoleName, clientRoleName, roleIds string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client_scope" "client_scope" {
+	name     = "%s"
+	realm_id = data.keycloak_realm.realm.id
+}
+
+resource "keycloak_openid_client" "client" {
+	client_id   = "%s"
+	realm_id    = data.keycloak_realm.realm.id
+	access_type = "BEARER-ONLY"
+}
+
+resource "keycloak_role" "realm_role" {
+	realm_id = data.keycloak_realm.realm.id
+	name     = "%s"
+}
+
+resource "keycloak_role" "client_role" {
+	realm_id  = data.keycloak_realm.realm.id
+	client_id = keycloak_openid_client.client.id
+	name      = "%s"
+}
+
+resource "keycloak_client_scope_role_mappings" "mappings" {
+	realm_id        = data.keycloak_realm.realm.id
+	client_scope_id = keycloak_openid_client_scope.client_scope.id
+	role_ids        = [%s]
+}
+	`, testAccRealm.Realm, clientScopeName, clientId, realmRoleName, clientRoleName, roleIds)
+}
@@ -0,0 +1,26 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/charlesderek/terraform-w-keycloak/keycloak"
+)
+
+func resourceKeycloakFacebookIdentityProvider() *schema.Resource {
+	facebookSchema := map[string]*schema.Schema{
+		"fetched_fields": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Comma separated list of additional profile fields to fetch from Facebook, beyond the default id, name, email, first_name and last_name.",
+		},
+	}
+
+	return resourceKeycloakSocialIdentityProvider("facebook", facebookSchema, getFacebookIdentityProviderConfigFromData, setFacebookIdentityProviderConfigData)
+}
+
+func getFacebookIdentityProviderConfigFromData(data *schema.ResourceData, config *keycloak.IdentityProviderConfig) {
+	setSocialIdentityProviderConfigValue(config, "fetchedFields", data.Get("fetched_fields").(string))
+}
+
+func setFacebookIdentityProviderConfigData(data *schema.ResourceData, config *keycloak.IdentityProviderConfig) {
+	data.Set("fetched_fields", getSocialIdentityProviderConfigValue(config, "fetchedFields"))
+}
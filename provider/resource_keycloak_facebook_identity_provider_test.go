@@ -0,0 +1,123 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/charlesderek/terraform-w-keycloak/keycloak"
+	"testing"
+)
+
+func TestAccKeycloakFacebookIdentityProvider_basic(t *testing.T) {
+	t.Parallel()
+
+	alias := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakSocialIdentityProviderDestroy("keycloak_facebook_identity_provider"),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakFacebookIdentityProvider_basic(alias),
+				Check:  testAccCheckKeycloakSocialIdentityProviderExists("keycloak_facebook_identity_provider.facebook", "facebook"),
+			},
+			{
+				ResourceName:            "keycloak_facebook_identity_provider.facebook",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdPrefix:     testAccRealm.Realm + "/",
+				ImportStateVerifyIgnore: []string{"client_secret"},
+			},
+		},
+	})
+}
+
+func TestAccKeycloakFacebookIdentityProvider_createAfterManualDestroy(t *testing.T) {
+	t.Parallel()
+
+	var idp = &keycloak.IdentityProvider{}
+	alias := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakSocialIdentityProviderDestroy("keycloak_facebook_identity_provider"),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakFacebookIdentityProvider_basic(alias),
+				Check:  testAccCheckKeycloakSocialIdentityProviderFetch("keycloak_facebook_identity_provider.facebook", idp),
+			},
+			{
+				PreConfig: func() {
+					err := keycloakClient.DeleteIdentityProvider(testCtx, idp.Realm, idp.Alias)
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testKeycloakFacebookIdentityProvider_basic(alias),
+				Check:  testAccCheckKeycloakSocialIdentityProviderExists("keycloak_facebook_identity_provider.facebook", "facebook"),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakFacebookIdentityProvider_providerConfig(t *testing.T) {
+	t.Parallel()
+
+	var idp = &keycloak.IdentityProvider{}
+	alias := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakSocialIdentityProviderDestroy("keycloak_facebook_identity_provider"),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakFacebookIdentityProvider_providerConfig(alias),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakSocialIdentityProviderFetch("keycloak_facebook_identity_provider.facebook", idp),
+					func(_ *terraform.State) error {
+						if idp.Config.ExtraConfig["fetchedFields"] != "birthday,gender" {
+							return fmt.Errorf("expected fetched_fields to be %s, but was %s", "birthday,gender", idp.Config.ExtraConfig["fetchedFields"])
+						}
+
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func testKeycloakFacebookIdentityProvider_basic(alias string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_facebook_identity_provider" "facebook" {
+	realm         = data.keycloak_realm.realm.id
+	alias         = "%s"
+	client_id     = "example_id"
+	client_secret = "example_token"
+}
+	`, testAccRealm.Realm, alias)
+}
+
+func testKeycloakFacebookIdentityProvider_providerConfig(alias string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_facebook_identity_provider" "facebook" {
+	realm         = data.keycloak_realm.realm.id
+	alias         = "%s"
+	client_id     = "example_id"
+	client_secret = "example_token"
+	fetched_fields = "birthday,gender"
+}
+	`, testAccRealm.Realm, alias)
+}
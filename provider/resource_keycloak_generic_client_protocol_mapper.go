@@ -22,6 +22,7 @@ func resourceKeycloakGenericClientProtocolMapper() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: genericProtocolMapperImport,
 		},
+		CustomizeDiff:      validateGenericProtocolMapperCustomizeDiff,
 		DeprecationMessage: "please use keycloak_generic_protocol_mapper instead",
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -110,7 +111,7 @@ func resourceKeycloakGenericClientProtocolMapperCreate(ctx context.Context, data
 
 	genericClientProtocolMapper := mapFromDataToGenericClientProtocolMapper(data)
 
-	err := genericClientProtocolMapper.Validate(ctx, keycloakClient)
+	warnings, err := genericClientProtocolMapper.Validate(ctx, keycloakClient)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -121,7 +122,7 @@ func resourceKeycloakGenericClientProtocolMapperCreate(ctx context.Context, data
 	}
 	mapFromGenericClientProtocolMapperToData(data, genericClientProtocolMapper)
 
-	return resourceKeycloakGenericClientProtocolMapperRead(ctx, data, meta)
+	return append(getGenericProtocolMapperConfigWarnings(warnings), resourceKeycloakGenericClientProtocolMapperRead(ctx, data, meta)...)
 }
 
 func resourceKeycloakGenericClientProtocolMapperRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -137,6 +138,8 @@ func resourceKeycloakGenericClientProtocolMapperRead(ctx context.Context, data *
 		return handleNotFoundError(ctx, err, data)
 	}
 
+	normalizeGenericProtocolMapperConfig(ctx, keycloakClient, data, resource)
+
 	mapFromGenericClientProtocolMapperToData(data, resource)
 
 	return nil
@@ -147,14 +150,19 @@ func resourceKeycloakGenericClientProtocolMapperUpdate(ctx context.Context, data
 
 	resource := mapFromDataToGenericClientProtocolMapper(data)
 
-	err := keycloakClient.UpdateGenericProtocolMapper(ctx, resource)
+	warnings, err := keycloakClient.ValidateProtocolMapperConfig(ctx, resource.Protocol, resource.ProtocolMapper, resource.Config)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = keycloakClient.UpdateGenericProtocolMapper(ctx, resource)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	mapFromGenericClientProtocolMapperToData(data, resource)
 
-	return nil
+	return getGenericProtocolMapperConfigWarnings(warnings)
 }
 
 func resourceKeycloakGenericClientProtocolMapperDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
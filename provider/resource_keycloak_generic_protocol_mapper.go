@@ -22,6 +22,7 @@ func resourceKeycloakGenericProtocolMapper() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: genericProtocolMapperImport,
 		},
+		CustomizeDiff: validateGenericProtocolMapperCustomizeDiff,
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -109,7 +110,7 @@ func resourceKeycloakGenericProtocolMapperCreate(ctx context.Context, data *sche
 
 	genericProtocolMapper := mapFromDataToGenericProtocolMapper(data)
 
-	err := genericProtocolMapper.Validate(ctx, keycloakClient)
+	warnings, err := genericProtocolMapper.Validate(ctx, keycloakClient)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -120,7 +121,7 @@ func resourceKeycloakGenericProtocolMapperCreate(ctx context.Context, data *sche
 	}
 	mapFromGenericProtocolMapperToData(data, genericProtocolMapper)
 
-	return resourceKeycloakGenericProtocolMapperRead(ctx, data, meta)
+	return append(getGenericProtocolMapperConfigWarnings(warnings), resourceKeycloakGenericProtocolMapperRead(ctx, data, meta)...)
 }
 
 func resourceKeycloakGenericProtocolMapperRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -136,6 +137,8 @@ func resourceKeycloakGenericProtocolMapperRead(ctx context.Context, data *schema
 		return handleNotFoundError(ctx, err, data)
 	}
 
+	normalizeGenericProtocolMapperConfig(ctx, keycloakClient, data, resource)
+
 	mapFromGenericProtocolMapperToData(data, resource)
 
 	return nil
@@ -146,14 +149,19 @@ func resourceKeycloakGenericProtocolMapperUpdate(ctx context.Context, data *sche
 
 	resource := mapFromDataToGenericProtocolMapper(data)
 
-	err := keycloakClient.UpdateGenericProtocolMapper(ctx, resource)
+	warnings, err := keycloakClient.ValidateProtocolMapperConfig(ctx, resource.Protocol, resource.ProtocolMapper, resource.Config)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = keycloakClient.UpdateGenericProtocolMapper(ctx, resource)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	mapFromGenericProtocolMapperToData(data, resource)
 
-	return nil
+	return getGenericProtocolMapperConfigWarnings(warnings)
 }
 
 func resourceKeycloakGenericProtocolMapperDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
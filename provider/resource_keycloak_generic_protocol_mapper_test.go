@@ -2,6 +2,7 @@ package provider
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/charlesderek/terraform-w-keycloak/keycloak"
@@ -113,6 +114,101 @@ func TestAccKeycloakGenericProtocolMapper_update(t *testing.T) {
 	})
 }
 
+func TestAccKeycloakGenericProtocolMapper_validateProtocolMapper(t *testing.T) {
+	t.Parallel()
+
+	clientId := acctest.RandomWithPrefix("tf-acc")
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccKeycloakGenericProtocolMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakGenericProtocolMapper_config(clientId, mapperName, "saml-hardcode-atribute-mapper", `"attribute.name" = "name"`),
+				ExpectError: regexp.MustCompile("validation error: protocol mapper saml-hardcode-atribute-mapper is not available for protocol saml, did you mean saml-hardcode-attribute-mapper\\?"),
+			},
+			{
+				Config:      testKeycloakGenericProtocolMapper_config(clientId, mapperName, "oidc-usermodel-property-mapper", `"attribute.name" = "name"`),
+				ExpectError: regexp.MustCompile("validation error: protocol mapper oidc-usermodel-property-mapper is not available for protocol saml"),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakGenericProtocolMapper_validateConfig(t *testing.T) {
+	t.Parallel()
+
+	clientId := acctest.RandomWithPrefix("tf-acc")
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccKeycloakGenericProtocolMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakGenericProtocolMapper_config(clientId, mapperName, "saml-hardcode-attribute-mapper", `"attribute.nameformat" = "Basik"`),
+				ExpectError: regexp.MustCompile("validation error: config key attribute.nameformat of protocol mapper saml-hardcode-attribute-mapper must be one of"),
+			},
+			{
+				Config:      testKeycloakGenericProtocolMapper_config(clientId, mapperName, "saml-role-list-mapper", `"single" = "yes"`),
+				ExpectError: regexp.MustCompile("validation error: config key single of protocol mapper saml-role-list-mapper must be either true or false"),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakGenericProtocolMapper_unsupportedConfigKey(t *testing.T) {
+	t.Parallel()
+
+	clientId := acctest.RandomWithPrefix("tf-acc")
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+
+	resourceName := "keycloak_generic_protocol_mapper.client_protocol_mapper"
+
+	// keycloak stores config keys that the mapper doesn't describe, so these are only reported as a warning
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccKeycloakGenericProtocolMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakGenericProtocolMapper_config(clientId, mapperName, "saml-role-list-mapper", `"attribute.name" = "Role", "custom.key" = "value"`),
+				Check: resource.ComposeTestCheckFunc(
+					testKeycloakGenericProtocolMapperExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "config.custom.key", "value"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakGenericProtocolMapper_partialConfig(t *testing.T) {
+	t.Parallel()
+
+	clientId := acctest.RandomWithPrefix("tf-acc")
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+
+	resourceName := "keycloak_generic_protocol_mapper.client_protocol_mapper"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccKeycloakGenericProtocolMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakGenericProtocolMapper_config(clientId, mapperName, "saml-role-list-mapper", `"attribute.name" = "Role"`),
+				Check: resource.ComposeTestCheckFunc(
+					testKeycloakGenericProtocolMapperExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "config.%", "1"),
+				),
+			},
+		},
+	})
+}
+
 func testAccKeycloakGenericProtocolMapperDestroy() resource.TestCheckFunc {
 	return func(state *terraform.State) error {
 		for resourceName, rs := range state.RootModule().Resources {
@@ -247,6 +343,29 @@ resource "keycloak_generic_protocol_mapper" "client_protocol_mapper" {
 }`, testAccRealm.Realm, clientId, mapperName, attributeName, attributeValue, mapperName)
 }
 
+func testKeycloakGenericProtocolMapper_config(clientId, mapperName, protocolMapper, config string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_saml_client" "saml_client" {
+	realm_id  = data.keycloak_realm.realm.id
+	client_id = "%s"
+}
+
+resource "keycloak_generic_protocol_mapper" "client_protocol_mapper" {
+	client_id       = keycloak_saml_client.saml_client.id
+	name            = "%s"
+	protocol        = "saml"
+	protocol_mapper = "%s"
+	realm_id        = data.keycloak_realm.realm.id
+	config = {
+		%s
+	}
+}`, testAccRealm.Realm, clientId, mapperName, protocolMapper, config)
+}
+
 func testKeycloakGenericProtocolMapperExists(resourceName string) resource.TestCheckFunc {
 	return func(state *terraform.State) error {
 		_, err := getGenericProtocolMapperUsingState(state, resourceName)
@@ -0,0 +1,33 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/charlesderek/terraform-w-keycloak/keycloak"
+)
+
+func resourceKeycloakGithubIdentityProvider() *schema.Resource {
+	githubSchema := map[string]*schema.Schema{
+		"base_url": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Override the default GitHub base URL. Only needed when using GitHub Enterprise.",
+		},
+		"api_url": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Override the default GitHub API URL. Only needed when using GitHub Enterprise.",
+		},
+	}
+
+	return resourceKeycloakSocialIdentityProvider("github", githubSchema, getGithubIdentityProviderConfigFromData, setGithubIdentityProviderConfigData)
+}
+
+func getGithubIdentityProviderConfigFromData(data *schema.ResourceData, config *keycloak.IdentityProviderConfig) {
+	setSocialIdentityProviderConfigValue(config, "baseUrl", data.Get("base_url").(string))
+	setSocialIdentityProviderConfigValue(config, "apiUrl", data.Get("api_url").(string))
+}
+
+func setGithubIdentityProviderConfigData(data *schema.ResourceData, config *keycloak.IdentityProviderConfig) {
+	data.Set("base_url", getSocialIdentityProviderConfigValue(config, "baseUrl"))
+	data.Set("api_url", getSocialIdentityProviderConfigValue(config, "apiUrl"))
+}
@@ -0,0 +1,128 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/charlesderek/terraform-w-keycloak/keycloak"
+	"testing"
+)
+
+func TestAccKeycloakGithubIdentityProvider_basic(t *testing.T) {
+	t.Parallel()
+
+	alias := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakSocialIdentityProviderDestroy("keycloak_github_identity_provider"),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakGithubIdentityProvider_basic(alias),
+				Check:  testAccCheckKeycloakSocialIdentityProviderExists("keycloak_github_identity_provider.github", "github"),
+			},
+			{
+				ResourceName:            "keycloak_github_identity_provider.github",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdPrefix:     testAccRealm.Realm + "/",
+				ImportStateVerifyIgnore: []string{"client_secret"},
+			},
+		},
+	})
+}
+
+func TestAccKeycloakGithubIdentityProvider_createAfterManualDestroy(t *testing.T) {
+	t.Parallel()
+
+	var idp = &keycloak.IdentityProvider{}
+	alias := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakSocialIdentityProviderDestroy("keycloak_github_identity_provider"),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakGithubIdentityProvider_basic(alias),
+				Check:  testAccCheckKeycloakSocialIdentityProviderFetch("keycloak_github_identity_provider.github", idp),
+			},
+			{
+				PreConfig: func() {
+					err := keycloakClient.DeleteIdentityProvider(testCtx, idp.Realm, idp.Alias)
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testKeycloakGithubIdentityProvider_basic(alias),
+				Check:  testAccCheckKeycloakSocialIdentityProviderExists("keycloak_github_identity_provider.github", "github"),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakGithubIdentityProvider_providerConfig(t *testing.T) {
+	t.Parallel()
+
+	var idp = &keycloak.IdentityProvider{}
+	alias := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakSocialIdentityProviderDestroy("keycloak_github_identity_provider"),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakGithubIdentityProvider_providerConfig(alias),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakSocialIdentityProviderFetch("keycloak_github_identity_provider.github", idp),
+					func(_ *terraform.State) error {
+						if idp.Config.ExtraConfig["baseUrl"] != "https://github.example.com" {
+							return fmt.Errorf("expected base_url to be %s, but was %s", "https://github.example.com", idp.Config.ExtraConfig["baseUrl"])
+						}
+
+						if idp.Config.ExtraConfig["apiUrl"] != "https://api.github.example.com" {
+							return fmt.Errorf("expected api_url to be %s, but was %s", "https://api.github.example.com", idp.Config.ExtraConfig["apiUrl"])
+						}
+
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func testKeycloakGithubIdentityProvider_basic(alias string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_github_identity_provider" "github" {
+	realm         = data.keycloak_realm.realm.id
+	alias         = "%s"
+	client_id     = "example_id"
+	client_secret = "example_token"
+}
+	`, testAccRealm.Realm, alias)
+}
+
+func testKeycloakGithubIdentityProvider_providerConfig(alias string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_github_identity_provider" "github" {
+	realm         = data.keycloak_realm.realm.id
+	alias         = "%s"
+	client_id     = "example_id"
+	client_secret = "example_token"
+	base_url      = "https://github.example.com"
+	api_url       = "https://api.github.example.com"
+}
+	`, testAccRealm.Realm, alias)
+}
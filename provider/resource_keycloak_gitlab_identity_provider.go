@@ -0,0 +1,9 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceKeycloakGitlabIdentityProvider() *schema.Resource {
+	return resourceKeycloakSocialIdentityProvider("gitlab", nil, nil, nil)
+}
@@ -0,0 +1,9 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceKeycloakInstagramIdentityProvider() *schema.Resource {
+	return resourceKeycloakSocialIdentityProvider("instagram", nil, nil, nil)
+}
@@ -0,0 +1,9 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceKeycloakLinkedinIdentityProvider() *schema.Resource {
+	return resourceKeycloakSocialIdentityProvider("linkedin-openid-connect", nil, nil, nil)
+}
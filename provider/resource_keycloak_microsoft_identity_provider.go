@@ -0,0 +1,26 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/charlesderek/terraform-w-keycloak/keycloak"
+)
+
+func resourceKeycloakMicrosoftIdentityProvider() *schema.Resource {
+	microsoftSchema := map[string]*schema.Schema{
+		"tenant_id": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Restricts logins to a single Microsoft Entra ID tenant. When empty, users from any tenant or personal Microsoft account may log in.",
+		},
+	}
+
+	return resourceKeycloakSocialIdentityProvider("microsoft", microsoftSchema, getMicrosoftIdentityProviderConfigFromData, setMicrosoftIdentityProviderConfigData)
+}
+
+func getMicrosoftIdentityProviderConfigFromData(data *schema.ResourceData, config *keycloak.IdentityProviderConfig) {
+	setSocialIdentityProviderConfigValue(config, "tenantId", data.Get("tenant_id").(string))
+}
+
+func setMicrosoftIdentityProviderConfigData(data *schema.ResourceData, config *keycloak.IdentityProviderConfig) {
+	data.Set("tenant_id", getSocialIdentityProviderConfigValue(config, "tenantId"))
+}
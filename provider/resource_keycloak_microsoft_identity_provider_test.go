@@ -0,0 +1,123 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/charlesderek/terraform-w-keycloak/keycloak"
+	"testing"
+)
+
+func TestAccKeycloakMicrosoftIdentityProvider_basic(t *testing.T) {
+	t.Parallel()
+
+	alias := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakSocialIdentityProviderDestroy("keycloak_microsoft_identity_provider"),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakMicrosoftIdentityProvider_basic(alias),
+				Check:  testAccCheckKeycloakSocialIdentityProviderExists("keycloak_microsoft_identity_provider.microsoft", "microsoft"),
+			},
+			{
+				ResourceName:            "keycloak_microsoft_identity_provider.microsoft",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdPrefix:     testAccRealm.Realm + "/",
+				ImportStateVerifyIgnore: []string{"client_secret"},
+			},
+		},
+	})
+}
+
+func TestAccKeycloakMicrosoftIdentityProvider_createAfterManualDestroy(t *testing.T) {
+	t.Parallel()
+
+	var idp = &keycloak.IdentityProvider{}
+	alias := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakSocialIdentityProviderDestroy("keycloak_microsoft_identity_provider"),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakMicrosoftIdentityProvider_basic(alias),
+				Check:  testAccCheckKeycloakSocialIdentityProviderFetch("keycloak_microsoft_identity_provider.microsoft", idp),
+			},
+			{
+				PreConfig: func() {
+					err := keycloakClient.DeleteIdentityProvider(testCtx, idp.Realm, idp.Alias)
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testKeycloakMicrosoftIdentityProvider_basic(alias),
+				Check:  testAccCheckKeycloakSocialIdentityProviderExists("keycloak_microsoft_identity_provider.microsoft", "microsoft"),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakMicrosoftIdentityProvider_providerConfig(t *testing.T) {
+	t.Parallel()
+
+	var idp = &keycloak.IdentityProvider{}
+	alias := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakSocialIdentityProviderDestroy("keycloak_microsoft_identity_provider"),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakMicrosoftIdentityProvider_providerConfig(alias),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakSocialIdentityProviderFetch("keycloak_microsoft_identity_provider.microsoft", idp),
+					func(_ *terraform.State) error {
+						if idp.Config.ExtraConfig["tenantId"] != "00000000-0000-0000-0000-000000000000" {
+							return fmt.Errorf("expected tenant_id to be %s, but was %s", "00000000-0000-0000-0000-000000000000", idp.Config.ExtraConfig["tenantId"])
+						}
+
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func testKeycloakMicrosoftIdentityProvider_basic(alias string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_microsoft_identity_provider" "microsoft" {
+	realm         = data.keycloak_realm.realm.id
+	alias         = "%s"
+	client_id     = "example_id"
+	client_secret = "example_token"
+}
+	`, testAccRealm.Realm, alias)
+}
+
+func testKeycloakMicrosoftIdentityProvider_providerConfig(alias string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_microsoft_identity_provider" "microsoft" {
+	realm         = data.keycloak_realm.realm.id
+	alias         = "%s"
+	client_id     = "example_id"
+	client_secret = "example_token"
+	tenant_id     = "00000000-0000-0000-0000-000000000000"
+}
+	`, testAccRealm.Realm, alias)
+}
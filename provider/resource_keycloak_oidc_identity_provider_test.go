@@ -50,6 +50,30 @@ func TestAccKeycloakOidcIdentityProvider_extraConfig(t *testing.T) {
 	})
 }
 
+// the social provider keys, such as baseUrl, only have top-level attributes in the social identity provider resources, so
+// they can still be set through extra_config here
+func TestAccKeycloakOidcIdentityProvider_extraConfigSocialKey(t *testing.T) {
+	t.Parallel()
+
+	oidcName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakOidcIdentityProviderDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOidcIdentityProvider_extra_config(oidcName, "baseUrl", "https://idp.example.com"),
+				Check:  resource.TestCheckResourceAttr("keycloak_oidc_identity_provider.oidc", "extra_config.baseUrl", "https://idp.example.com"),
+			},
+			{
+				Config:   testKeycloakOidcIdentityProvider_extra_config(oidcName, "baseUrl", "https://idp.example.com"),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 // ensure that extra_config keys which are covered by top-level attributes are not allowed
 func TestAccKeycloakOidcIdentityProvider_extraConfigInvalid(t *testing.T) {
 	t.Parallel()
@@ -0,0 +1,171 @@
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/charlesderek/terraform-w-keycloak/keycloak"
+)
+
+func resourceKeycloakOpenIdAcrProtocolMapper() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKeycloakOpenIdAcrProtocolMapperCreate,
+		ReadContext:   resourceKeycloakOpenIdAcrProtocolMapperRead,
+		UpdateContext: resourceKeycloakOpenIdAcrProtocolMapperUpdate,
+		DeleteContext: resourceKeycloakOpenIdAcrProtocolMapperDelete,
+		Importer: &schema.ResourceImporter{
+			// import a mapper tied to a client:
+			// {{realmId}}/client/{{clientId}}/{{protocolMapperId}}
+			// or a client scope:
+			// {{realmId}}/client-scope/{{clientScopeId}}/{{protocolMapperId}}
+			StateContext: genericProtocolMapperImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "A human-friendly name that will appear in the Keycloak console.",
+			},
+			"realm_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The realm id where the associated client or client scope exists.",
+			},
+			"client_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				Description:   "The mapper's associated client. Cannot be used at the same time as client_scope_id.",
+				ConflictsWith: []string{"client_scope_id"},
+			},
+			"client_scope_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				Description:   "The mapper's associated client scope. Cannot be used at the same time as client_id.",
+				ConflictsWith: []string{"client_id"},
+			},
+			"add_to_id_token": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"add_to_access_token": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"add_to_token_introspection": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Indicates if the attribute should be a claim in the token introspection response. Defaults to the value of add_to_access_token.",
+			},
+			"add_to_lightweight_claim": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Indicates if the attribute should be a claim in lightweight access tokens.",
+			},
+		},
+	}
+}
+
+func mapFromDataToOpenIdAcrProtocolMapper(data *schema.ResourceData) *keycloak.OpenIdAcrProtocolMapper {
+	return &keycloak.OpenIdAcrProtocolMapper{
+		Id:            data.Id(),
+		Name:          data.Get("name").(string),
+		RealmId:       data.Get("realm_id").(string),
+		ClientId:      data.Get("client_id").(string),
+		ClientScopeId: data.Get("client_scope_id").(string),
+
+		AddToIdToken:            data.Get("add_to_id_token").(bool),
+		AddToAccessToken:        data.Get("add_to_access_token").(bool),
+		AddToTokenIntrospection: getAddToTokenIntrospectionFromData(data),
+		AddToLightweightClaim:   data.Get("add_to_lightweight_claim").(bool),
+	}
+}
+
+func mapFromOpenIdAcrMapperToData(mapper *keycloak.OpenIdAcrProtocolMapper, data *schema.ResourceData) {
+	data.SetId(mapper.Id)
+	data.Set("name", mapper.Name)
+	data.Set("realm_id", mapper.RealmId)
+
+	if mapper.ClientId != "" {
+		data.Set("client_id", mapper.ClientId)
+	} else {
+		data.Set("client_scope_id", mapper.ClientScopeId)
+	}
+
+	data.Set("add_to_id_token", mapper.AddToIdToken)
+	data.Set("add_to_access_token", mapper.AddToAccessToken)
+	data.Set("add_to_token_introspection", mapper.AddToTokenIntrospection)
+	data.Set("add_to_lightweight_claim", mapper.AddToLightweightClaim)
+}
+
+func resourceKeycloakOpenIdAcrProtocolMapperCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	openIdAcrMapper := mapFromDataToOpenIdAcrProtocolMapper(data)
+
+	err := keycloakClient.ValidateOpenIdAcrProtocolMapper(ctx, openIdAcrMapper)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = keycloakClient.NewOpenIdAcrProtocolMapper(ctx, openIdAcrMapper)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	mapFromOpenIdAcrMapperToData(openIdAcrMapper, data)
+
+	return resourceKeycloakOpenIdAcrProtocolMapperRead(ctx, data, meta)
+}
+
+func resourceKeycloakOpenIdAcrProtocolMapperRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	clientId := data.Get("client_id").(string)
+	clientScopeId := data.Get("client_scope_id").(string)
+
+	openIdAcrMapper, err := keycloakClient.GetOpenIdAcrProtocolMapper(ctx, realmId, clientId, clientScopeId, data.Id())
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	mapFromOpenIdAcrMapperToData(openIdAcrMapper, data)
+
+	return nil
+}
+
+func resourceKeycloakOpenIdAcrProtocolMapperUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	openIdAcrMapper := mapFromDataToOpenIdAcrProtocolMapper(data)
+
+	err := keycloakClient.ValidateOpenIdAcrProtocolMapper(ctx, openIdAcrMapper)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = keycloakClient.UpdateOpenIdAcrProtocolMapper(ctx, openIdAcrMapper)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceKeycloakOpenIdAcrProtocolMapperRead(ctx, data, meta)
+}
+
+func resourceKeycloakOpenIdAcrProtocolMapperDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	clientId := data.Get("client_id").(string)
+	clientScopeId := data.Get("client_scope_id").(string)
+
+	return diag.FromErr(keycloakClient.DeleteOpenIdAcrProtocolMapper(ctx, realmId, clientId, clientScopeId, data.Id()))
+}
@@ -0,0 +1,180 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/charlesderek/terraform-w-keycloak/keycloak"
+)
+
+func TestAccKeycloakOpenIdAcrProtocolMapper_import(t *testing.T) {
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
+	clientScopeId := acctest.RandomWithPrefix("tf-acc")
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+
+	clientResourceName := "keycloak_openid_acr_protocol_mapper.acr_mapper_client"
+	clientScopeResourceName := "keycloak_openid_acr_protocol_mapper.acr_mapper_client_scope"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccKeycloakOpenIdAcrProtocolMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOpenIdAcrProtocolMapper_import(clientId, clientScopeId, mapperName),
+				Check: resource.ComposeTestCheckFunc(
+					testKeycloakOpenIdAcrProtocolMapperExists(clientResourceName),
+					testKeycloakOpenIdAcrProtocolMapperExists(clientScopeResourceName),
+				),
+			},
+			{
+				ResourceName:      clientResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: getGenericProtocolMapperIdForClient(clientResourceName),
+			},
+			{
+				ResourceName:      clientScopeResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: getGenericProtocolMapperIdForClientScope(clientScopeResourceName),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakOpenIdAcrProtocolMapper_update(t *testing.T) {
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+
+	resourceName := "keycloak_openid_acr_protocol_mapper.acr_mapper"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccKeycloakOpenIdAcrProtocolMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOpenIdAcrProtocolMapper_claims(clientId, mapperName, true, true, true, false),
+				Check: resource.ComposeTestCheckFunc(
+					testKeycloakOpenIdAcrProtocolMapperExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "add_to_id_token", "true"),
+					resource.TestCheckResourceAttr(resourceName, "add_to_access_token", "true"),
+					resource.TestCheckResourceAttr(resourceName, "add_to_token_introspection", "true"),
+					resource.TestCheckResourceAttr(resourceName, "add_to_lightweight_claim", "false"),
+				),
+			},
+			{
+				Config: testKeycloakOpenIdAcrProtocolMapper_claims(clientId, mapperName, false, false, false, true),
+				Check: resource.ComposeTestCheckFunc(
+					testKeycloakOpenIdAcrProtocolMapperExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "add_to_id_token", "false"),
+					resource.TestCheckResourceAttr(resourceName, "add_to_access_token", "false"),
+					resource.TestCheckResourceAttr(resourceName, "add_to_token_introspection", "false"),
+					resource.TestCheckResourceAttr(resourceName, "add_to_lightweight_claim", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccKeycloakOpenIdAcrProtocolMapperDestroy() resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		for resourceName, rs := range state.RootModule().Resources {
+			if rs.Type != "keycloak_openid_acr_protocol_mapper" {
+				continue
+			}
+
+			mapper, _ := getAcrMapperUsingState(state, resourceName)
+
+			if mapper != nil {
+				return fmt.Errorf("openid acr protocol mapper with id %s still exists", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakOpenIdAcrProtocolMapperExists(resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		_, err := getAcrMapperUsingState(state, resourceName)
+
+		return err
+	}
+}
+
+func getAcrMapperUsingState(state *terraform.State, resourceName string) (*keycloak.OpenIdAcrProtocolMapper, error) {
+	rs, ok := state.RootModule().Resources[resourceName]
+	if !ok {
+		return nil, fmt.Errorf("resource not found in TF state: %s ", resourceName)
+	}
+
+	id := rs.Primary.ID
+	realm := rs.Primary.Attributes["realm_id"]
+	clientId := rs.Primary.Attributes["client_id"]
+	clientScopeId := rs.Primary.Attributes["client_scope_id"]
+
+	return keycloakClient.GetOpenIdAcrProtocolMapper(testCtx, realm, clientId, clientScopeId, id)
+}
+
+func testKeycloakOpenIdAcrProtocolMapper_import(clientId, clientScopeId, mapperName string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client" "openid_client" {
+	realm_id    = data.keycloak_realm.realm.id
+	client_id   = "%s"
+
+	access_type = "BEARER-ONLY"
+}
+
+resource "keycloak_openid_acr_protocol_mapper" "acr_mapper_client" {
+	name      = "%s"
+	realm_id  = data.keycloak_realm.realm.id
+	client_id = keycloak_openid_client.openid_client.id
+}
+
+resource "keycloak_openid_client_scope" "client_scope" {
+	name     = "%s"
+	realm_id = data.keycloak_realm.realm.id
+}
+
+resource "keycloak_openid_acr_protocol_mapper" "acr_mapper_client_scope" {
+	name            = "%s"
+	realm_id        = data.keycloak_realm.realm.id
+	client_scope_id = keycloak_openid_client_scope.client_scope.id
+}`, testAccRealm.Realm, clientId, mapperName, clientScopeId, mapperName)
+}
+
+func testKeycloakOpenIdAcrProtocolMapper_claims(clientId, mapperName string, addToIdToken, addToAccessToken, addToTokenIntrospection, addToLightweightClaim bool) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client" "openid_client" {
+	realm_id    = data.keycloak_realm.realm.id
+	client_id   = "%s"
+
+	access_type = "BEARER-ONLY"
+}
+
+resource "keycloak_openid_acr_protocol_mapper" "acr_mapper" {
+	name      = "%s"
+	realm_id  = data.keycloak_realm.realm.id
+	client_id = keycloak_openid_client.openid_client.id
+
+	add_to_id_token            = %t
+	add_to_access_token        = %t
+	add_to_token_introspection = %t
+	add_to_lightweight_claim   = %t
+}`, testAccRealm.Realm, clientId, mapperName, addToIdToken, addToAccessToken, addToTokenIntrospection, addToLightweightClaim)
+}
@@ -0,0 +1,164 @@
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/charlesderek/terraform-w-keycloak/keycloak"
+)
+
+func resourceKeycloakOpenIdAllowedOriginsProtocolMapper() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKeycloakOpenIdAllowedOriginsProtocolMapperCreate,
+		ReadContext:   resourceKeycloakOpenIdAllowedOriginsProtocolMapperRead,
+		UpdateContext: resourceKeycloakOpenIdAllowedOriginsProtocolMapperUpdate,
+		DeleteContext: resourceKeycloakOpenIdAllowedOriginsProtocolMapperDelete,
+		Importer: &schema.ResourceImporter{
+			// import a mapper tied to a client:
+			// {{realmId}}/client/{{clientId}}/{{protocolMapperId}}
+			// or a client scope:
+			// {{realmId}}/client-scope/{{clientScopeId}}/{{protocolMapperId}}
+			StateContext: genericProtocolMapperImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "A human-friendly name that will appear in the Keycloak console.",
+			},
+			"realm_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The realm id where the associated client or client scope exists.",
+			},
+			"client_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				Description:   "The mapper's associated client. Cannot be used at the same time as client_scope_id.",
+				ConflictsWith: []string{"client_scope_id"},
+			},
+			"client_scope_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				Description:   "The mapper's associated client scope. Cannot be used at the same time as client_id.",
+				ConflictsWith: []string{"client_id"},
+			},
+			"add_to_access_token": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"add_to_token_introspection": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Indicates if the attribute should be a claim in the token introspection response. Defaults to the value of add_to_access_token.",
+			},
+			"add_to_lightweight_claim": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Indicates if the attribute should be a claim in lightweight access tokens.",
+			},
+		},
+	}
+}
+
+func mapFromDataToOpenIdAllowedOriginsProtocolMapper(data *schema.ResourceData) *keycloak.OpenIdAllowedOriginsProtocolMapper {
+	return &keycloak.OpenIdAllowedOriginsProtocolMapper{
+		Id:            data.Id(),
+		Name:          data.Get("name").(string),
+		RealmId:       data.Get("realm_id").(string),
+		ClientId:      data.Get("client_id").(string),
+		ClientScopeId: data.Get("client_scope_id").(string),
+
+		AddToAccessToken:        data.Get("add_to_access_token").(bool),
+		AddToTokenIntrospection: getAddToTokenIntrospectionFromData(data),
+		AddToLightweightClaim:   data.Get("add_to_lightweight_claim").(bool),
+	}
+}
+
+func mapFromOpenIdAllowedOriginsMapperToData(mapper *keycloak.OpenIdAllowedOriginsProtocolMapper, data *schema.ResourceData) {
+	data.SetId(mapper.Id)
+	data.Set("name", mapper.Name)
+	data.Set("realm_id", mapper.RealmId)
+
+	if mapper.ClientId != "" {
+		data.Set("client_id", mapper.ClientId)
+	} else {
+		data.Set("client_scope_id", mapper.ClientScopeId)
+	}
+
+	data.Set("add_to_access_token", mapper.AddToAccessToken)
+	data.Set("add_to_token_introspection", mapper.AddToTokenIntrospection)
+	data.Set("add_to_lightweight_claim", mapper.AddToLightweightClaim)
+}
+
+func resourceKeycloakOpenIdAllowedOriginsProtocolMapperCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	openIdAllowedOriginsMapper := mapFromDataToOpenIdAllowedOriginsProtocolMapper(data)
+
+	err := keycloakClient.ValidateOpenIdAllowedOriginsProtocolMapper(ctx, openIdAllowedOriginsMapper)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = keycloakClient.NewOpenIdAllowedOriginsProtocolMapper(ctx, openIdAllowedOriginsMapper)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	mapFromOpenIdAllowedOriginsMapperToData(openIdAllowedOriginsMapper, data)
+
+	return resourceKeycloakOpenIdAllowedOriginsProtocolMapperRead(ctx, data, meta)
+}
+
+func resourceKeycloakOpenIdAllowedOriginsProtocolMapperRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	clientId := data.Get("client_id").(string)
+	clientScopeId := data.Get("client_scope_id").(string)
+
+	openIdAllowedOriginsMapper, err := keycloakClient.GetOpenIdAllowedOriginsProtocolMapper(ctx, realmId, clientId, clientScopeId, data.Id())
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	mapFromOpenIdAllowedOriginsMapperToData(openIdAllowedOriginsMapper, data)
+
+	return nil
+}
+
+func resourceKeycloakOpenIdAllowedOriginsProtocolMapperUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	openIdAllowedOriginsMapper := mapFromDataToOpenIdAllowedOriginsProtocolMapper(data)
+
+	err := keycloakClient.ValidateOpenIdAllowedOriginsProtocolMapper(ctx, openIdAllowedOriginsMapper)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = keycloakClient.UpdateOpenIdAllowedOriginsProtocolMapper(ctx, openIdAllowedOriginsMapper)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceKeycloakOpenIdAllowedOriginsProtocolMapperRead(ctx, data, meta)
+}
+
+func resourceKeycloakOpenIdAllowedOriginsProtocolMapperDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	clientId := data.Get("client_id").(string)
+	clientScopeId := data.Get("client_scope_id").(string)
+
+	return diag.FromErr(keycloakClient.DeleteOpenIdAllowedOriginsProtocolMapper(ctx, realmId, clientId, clientScopeId, data.Id()))
+}
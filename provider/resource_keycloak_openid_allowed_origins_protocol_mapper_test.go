@@ -0,0 +1,177 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/charlesderek/terraform-w-keycloak/keycloak"
+)
+
+func TestAccKeycloakOpenIdAllowedOriginsProtocolMapper_import(t *testing.T) {
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
+	clientScopeId := acctest.RandomWithPrefix("tf-acc")
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+
+	clientResourceName := "keycloak_openid_allowed_origins_protocol_mapper.allowed_origins_mapper_client"
+	clientScopeResourceName := "keycloak_openid_allowed_origins_protocol_mapper.allowed_origins_mapper_client_scope"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccKeycloakOpenIdAllowedOriginsProtocolMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOpenIdAllowedOriginsProtocolMapper_import(clientId, clientScopeId, mapperName),
+				Check: resource.ComposeTestCheckFunc(
+					testKeycloakOpenIdAllowedOriginsProtocolMapperExists(clientResourceName),
+					testKeycloakOpenIdAllowedOriginsProtocolMapperExists(clientScopeResourceName),
+				),
+			},
+			{
+				ResourceName:      clientResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: getGenericProtocolMapperIdForClient(clientResourceName),
+			},
+			{
+				ResourceName:      clientScopeResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: getGenericProtocolMapperIdForClientScope(clientScopeResourceName),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakOpenIdAllowedOriginsProtocolMapper_update(t *testing.T) {
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+
+	resourceName := "keycloak_openid_allowed_origins_protocol_mapper.allowed_origins_mapper"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccKeycloakOpenIdAllowedOriginsProtocolMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOpenIdAllowedOriginsProtocolMapper_claims(clientId, mapperName, true, true, false),
+				Check: resource.ComposeTestCheckFunc(
+					testKeycloakOpenIdAllowedOriginsProtocolMapperExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "add_to_access_token", "true"),
+					resource.TestCheckResourceAttr(resourceName, "add_to_token_introspection", "true"),
+					resource.TestCheckResourceAttr(resourceName, "add_to_lightweight_claim", "false"),
+				),
+			},
+			{
+				Config: testKeycloakOpenIdAllowedOriginsProtocolMapper_claims(clientId, mapperName, false, false, true),
+				Check: resource.ComposeTestCheckFunc(
+					testKeycloakOpenIdAllowedOriginsProtocolMapperExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "add_to_access_token", "false"),
+					resource.TestCheckResourceAttr(resourceName, "add_to_token_introspection", "false"),
+					resource.TestCheckResourceAttr(resourceName, "add_to_lightweight_claim", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccKeycloakOpenIdAllowedOriginsProtocolMapperDestroy() resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		for resourceName, rs := range state.RootModule().Resources {
+			if rs.Type != "keycloak_openid_allowed_origins_protocol_mapper" {
+				continue
+			}
+
+			mapper, _ := getAllowedOriginsMapperUsingState(state, resourceName)
+
+			if mapper != nil {
+				return fmt.Errorf("openid allowed origins protocol mapper with id %s still exists", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakOpenIdAllowedOriginsProtocolMapperExists(resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		_, err := getAllowedOriginsMapperUsingState(state, resourceName)
+
+		return err
+	}
+}
+
+func getAllowedOriginsMapperUsingState(state *terraform.State, resourceName string) (*keycloak.OpenIdAllowedOriginsProtocolMapper, error) {
+	rs, ok := state.RootModule().Resources[resourceName]
+	if !ok {
+		return nil, fmt.Errorf("resource not found in TF state: %s ", resourceName)
+	}
+
+	id := rs.Primary.ID
+	realm := rs.Primary.Attributes["realm_id"]
+	clientId := rs.Primary.Attributes["client_id"]
+	clientScopeId := rs.Primary.Attributes["client_scope_id"]
+
+	return keycloakClient.GetOpenIdAllowedOriginsProtocolMapper(testCtx, realm, clientId, clientScopeId, id)
+}
+
+func testKeycloakOpenIdAllowedOriginsProtocolMapper_import(clientId, clientScopeId, mapperName string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client" "openid_client" {
+	realm_id    = data.keycloak_realm.realm.id
+	client_id   = "%s"
+
+	access_type = "BEARER-ONLY"
+}
+
+resource "keycloak_openid_allowed_origins_protocol_mapper" "allowed_origins_mapper_client" {
+	name      = "%s"
+	realm_id  = data.keycloak_realm.realm.id
+	client_id = keycloak_openid_client.openid_client.id
+}
+
+resource "keycloak_openid_client_scope" "client_scope" {
+	name     = "%s"
+	realm_id = data.keycloak_realm.realm.id
+}
+
+resource "keycloak_openid_allowed_origins_protocol_mapper" "allowed_origins_mapper_client_scope" {
+	name            = "%s"
+	realm_id        = data.keycloak_realm.realm.id
+	client_scope_id = keycloak_openid_client_scope.client_scope.id
+}`, testAccRealm.Realm, clientId, mapperName, clientScopeId, mapperName)
+}
+
+func testKeycloakOpenIdAllowedOriginsProtocolMapper_claims(clientId, mapperName string, addToAccessToken, addToTokenIntrospection, addToLightweightClaim bool) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client" "openid_client" {
+	realm_id    = data.keycloak_realm.realm.id
+	client_id   = "%s"
+
+	access_type = "BEARER-ONLY"
+}
+
+resource "keycloak_openid_allowed_origins_protocol_mapper" "allowed_origins_mapper" {
+	name      = "%s"
+	realm_id  = data.keycloak_realm.realm.id
+	client_id = keycloak_openid_client.openid_client.id
+
+	add_to_access_token        = %t
+	add_to_token_introspection = %t
+	add_to_lightweight_claim   = %t
+}`, testAccRealm.Realm, clientId, mapperName, addToAccessToken, addToTokenIntrospection, addToLightweightClaim)
+}
@@ -70,6 +70,18 @@ func resourceKeycloakOpenIdAudienceProtocolMapper() *schema.Resource {
 				Default:     true,
 				Description: "Indicates if this claim should be added to the access token.",
 			},
+			"add_to_token_introspection": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Indicates if the attribute should be a claim in the token introspection response. Defaults to the value of add_to_access_token.",
+			},
+			"add_to_lightweight_claim": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Indicates if the attribute should be a claim in lightweight access tokens.",
+			},
 		},
 	}
 }
@@ -82,8 +94,10 @@ func mapFromDataToOpenIdAudienceProtocolMapper(data *schema.ResourceData) *keycl
 		ClientId:      data.Get("client_id").(string),
 		ClientScopeId: data.Get("client_scope_id").(string),
 
-		AddToIdToken:     data.Get("add_to_id_token").(bool),
-		AddToAccessToken: data.Get("add_to_access_token").(bool),
+		AddToIdToken:            data.Get("add_to_id_token").(bool),
+		AddToAccessToken:        data.Get("add_to_access_token").(bool),
+		AddToTokenIntrospection: getAddToTokenIntrospectionFromData(data),
+		AddToLightweightClaim:   data.Get("add_to_lightweight_claim").(bool),
 
 		IncludedClientAudience: data.Get("included_client_audience").(string),
 		IncludedCustomAudience: data.Get("included_custom_audience").(string),
@@ -109,6 +123,8 @@ func mapFromOpenIdAudienceMapperToData(mapper *keycloak.OpenIdAudienceProtocolMa
 
 	data.Set("add_to_id_token", mapper.AddToIdToken)
 	data.Set("add_to_access_token", mapper.AddToAccessToken)
+	data.Set("add_to_token_introspection", mapper.AddToTokenIntrospection)
+	data.Set("add_to_lightweight_claim", mapper.AddToLightweightClaim)
 }
 
 func resourceKeycloakOpenIdAudienceProtocolMapperCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
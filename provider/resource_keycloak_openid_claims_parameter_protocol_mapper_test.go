@@ -0,0 +1,174 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/charlesderek/terraform-w-keycloak/keycloak"
+)
+
+func TestAccKeycloakOpenIdClaimsParameterProtocolMapper_import(t *testing.T) {
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
+	clientScopeId := acctest.RandomWithPrefix("tf-acc")
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+
+	clientResourceName := "keycloak_openid_claims_parameter_protocol_mapper.claims_parameter_mapper_client"
+	clientScopeResourceName := "keycloak_openid_claims_parameter_protocol_mapper.claims_parameter_mapper_client_scope"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccKeycloakOpenIdClaimsParameterProtocolMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOpenIdClaimsParameterProtocolMapper_import(clientId, clientScopeId, mapperName),
+				Check: resource.ComposeTestCheckFunc(
+					testKeycloakOpenIdClaimsParameterProtocolMapperExists(clientResourceName),
+					testKeycloakOpenIdClaimsParameterProtocolMapperExists(clientScopeResourceName),
+				),
+			},
+			{
+				ResourceName:      clientResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: getGenericProtocolMapperIdForClient(clientResourceName),
+			},
+			{
+				ResourceName:      clientScopeResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: getGenericProtocolMapperIdForClientScope(clientScopeResourceName),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakOpenIdClaimsParameterProtocolMapper_update(t *testing.T) {
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+
+	resourceName := "keycloak_openid_claims_parameter_protocol_mapper.claims_parameter_mapper"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccKeycloakOpenIdClaimsParameterProtocolMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOpenIdClaimsParameterProtocolMapper_claims(clientId, mapperName, true, true),
+				Check: resource.ComposeTestCheckFunc(
+					testKeycloakOpenIdClaimsParameterProtocolMapperExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "add_to_id_token", "true"),
+					resource.TestCheckResourceAttr(resourceName, "add_to_userinfo", "true"),
+				),
+			},
+			{
+				Config: testKeycloakOpenIdClaimsParameterProtocolMapper_claims(clientId, mapperName, false, false),
+				Check: resource.ComposeTestCheckFunc(
+					testKeycloakOpenIdClaimsParameterProtocolMapperExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "add_to_id_token", "false"),
+					resource.TestCheckResourceAttr(resourceName, "add_to_userinfo", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccKeycloakOpenIdClaimsParameterProtocolMapperDestroy() resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		for resourceName, rs := range state.RootModule().Resources {
+			if rs.Type != "keycloak_openid_claims_parameter_protocol_mapper" {
+				continue
+			}
+
+			mapper, _ := getClaimsParameterMapperUsingState(state, resourceName)
+
+			if mapper != nil {
+				return fmt.Errorf("openid claims parameter protocol mapper with id %s still exists", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakOpenIdClaimsParameterProtocolMapperExists(resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		_, err := getClaimsParameterMapperUsingState(state, resourceName)
+
+		return err
+	}
+}
+
+func getClaimsParameterMapperUsingState(state *terraform.State, resourceName string) (*keycloak.OpenIdClaimsParameterProtocolMapper, error) {
+	rs, ok := state.RootModule().Resources[resourceName]
+	if !ok {
+		return nil, fmt.Errorf("resource not found in TF state: %s ", resourceName)
+	}
+
+	id := rs.Primary.ID
+	realm := rs.Primary.Attributes["realm_id"]
+	clientId := rs.Primary.Attributes["client_id"]
+	clientScopeId := rs.Primary.Attributes["client_scope_id"]
+
+	return keycloakClient.GetOpenIdClaimsParameterProtocolMapper(testCtx, realm, clientId, clientScopeId, id)
+}
+
+func testKeycloakOpenIdClaimsParameterProtocolMapper_import(clientId, clientScopeId, mapperName string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client" "openid_client" {
+	realm_id    = data.keycloak_realm.realm.id
+	client_id   = "%s"
+
+	access_type = "BEARER-ONLY"
+}
+
+resource "keycloak_openid_claims_parameter_protocol_mapper" "claims_parameter_mapper_client" {
+	name      = "%s"
+	realm_id  = data.keycloak_realm.realm.id
+	client_id = keycloak_openid_client.openid_client.id
+}
+
+resource "keycloak_openid_client_scope" "client_scope" {
+	name     = "%s"
+	realm_id = data.keycloak_realm.realm.id
+}
+
+resource "keycloak_openid_claims_parameter_protocol_mapper" "claims_parameter_mapper_client_scope" {
+	name            = "%s"
+	realm_id        = data.keycloak_realm.realm.id
+	client_scope_id = keycloak_openid_client_scope.client_scope.id
+}`, testAccRealm.Realm, clientId, mapperName, clientScopeId, mapperName)
+}
+
+func testKeycloakOpenIdClaimsParameterProtocolMapper_claims(clientId, mapperName string, addToIdToken, addToUserInfo bool) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client" "openid_client" {
+	realm_id    = data.keycloak_realm.realm.id
+	client_id   = "%s"
+
+	access_type = "BEARER-ONLY"
+}
+
+resource "keycloak_openid_claims_parameter_protocol_mapper" "claims_parameter_mapper" {
+	name      = "%s"
+	realm_id  = data.keycloak_realm.realm.id
+	client_id = keycloak_openid_client.openid_client.id
+
+	add_to_id_token = %t
+	add_to_userinfo = %t
+}`, testAccRealm.Realm, clientId, mapperName, addToIdToken, addToUserInfo)
+}
@@ -2,13 +2,17 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"github.com/imdario/mergo"
 	"github.com/charlesderek/terraform-w-keycloak/keycloak/types"
 	"reflect"
+	"strconv"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
@@ -23,6 +27,9 @@ var (
 	keycloakOpenidClientResourcePermissionDecisionStrategies = []string{"UNANIMOUS", "AFFIRMATIVE", "CONSENSUS"}
 	keycloakOpenidClientPkceCodeChallengeMethod              = []string{"", "plain", "S256"}
 	keycloakOpenidClientAuthenticatorTypes                   = []string{"client-secret", "client-jwt", "client-x509", "client-secret-jwt"}
+	keycloakOpenidClientCibaTokenDeliveryModes               = []string{"poll", "ping"}
+	keycloakOpenidClientTokenExchangeRefreshTokenTypes       = []string{"NO", "SAME_SESSION"}
+	keycloakOpenidClientTokenEndpointAuthSigningAlgs         = []string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512", "EdDSA", "HS256", "HS384", "HS512"}
 )
 
 func resourceKeycloakOpenidClient() *schema.Resource {
@@ -71,12 +78,154 @@ func resourceKeycloakOpenidClient() *schema.Resource {
 				Computed:  true,
 				Sensitive: true,
 			},
+			"rotation_trigger": {
+				Type:          schema.TypeMap,
+				Optional:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				ConflictsWith: []string{"client_secret"},
+			},
+			"rotate_after": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  validateOpenidClientSecretRotateAfter,
+				ConflictsWith: []string{"client_secret"},
+			},
+			"client_secret_created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"client_secret_expires_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"client_secret_rotated": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"client_secret_rotated_created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"client_secret_rotated_expires_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"client_authenticator_type": {
 				Type:         schema.TypeString,
 				Optional:     true,
 				ValidateFunc: validation.StringInSlice(keycloakOpenidClientAuthenticatorTypes, false),
 				Default:      "client-secret",
 			},
+			"token_endpoint_auth_signing_alg": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(keycloakOpenidClientTokenEndpointAuthSigningAlgs, false),
+			},
+			"use_jwks_url": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"jwks_url": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"x509_subject_dn": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"x509_allow_regex_pattern_comparison": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"tls_client_certificate_bound_access_tokens": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"require_pushed_authorization_requests": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"ciba_grant_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"ciba_backchannel_token_delivery_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(keycloakOpenidClientCibaTokenDeliveryModes, false),
+			},
+			"ciba_backchannel_client_notification_endpoint": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"dpop_bound_access_tokens": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"id_token_signed_response_alg": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"id_token_encrypted_response_alg": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"id_token_encrypted_response_enc": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"user_info_response_signature_alg": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"user_info_encrypted_response_alg": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"user_info_encrypted_response_enc": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"authorization_signed_response_alg": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"authorization_encrypted_response_alg": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"authorization_encrypted_response_enc": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"standard_token_exchange_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"standard_token_exchange_refresh_token_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(keycloakOpenidClientTokenExchangeRefreshTokenTypes, false),
+			},
+			"acr_loa_map": {
+				Type:             schema.TypeMap,
+				Optional:         true,
+				Elem:             &schema.Schema{Type: schema.TypeString},
+				ValidateDiagFunc: validateOpenidClientAcrLoaMap,
+			},
+			"default_acr_values": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"standard_flow_enabled": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -282,7 +431,7 @@ func resourceKeycloakOpenidClient() *schema.Resource {
 			"extra_config": {
 				Type:             schema.TypeMap,
 				Optional:         true,
-				ValidateDiagFunc: validateExtraConfig(reflect.ValueOf(&keycloak.OpenidClientAttributes{}).Elem()),
+				ValidateDiagFunc: validateExtraConfigWithLegacyKeys(reflect.ValueOf(&keycloak.OpenidClientAttributes{}).Elem(), openidClientLegacyExtraConfigAttributes),
 			},
 			"oauth2_device_authorization_grant_enabled": {
 				Type:     schema.TypeBool,
@@ -304,9 +453,12 @@ func resourceKeycloakOpenidClient() *schema.Resource {
 				ForceNew: true,
 			},
 		},
-		CustomizeDiff: customdiff.ComputedIf("service_account_user_id", func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) bool {
-			return d.HasChange("service_accounts_enabled")
-		}),
+		CustomizeDiff: customdiff.All(
+			customdiff.ComputedIf("service_account_user_id", func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) bool {
+				return d.HasChange("service_accounts_enabled")
+			}),
+			openidClientSecretRotationCustomizeDiff,
+		),
 	}
 }
 
@@ -377,6 +529,29 @@ func getOpenidClientFromData(data *schema.ResourceData) (*keycloak.OpenidClient,
 			ConsentScreenText:                     data.Get("consent_screen_text").(string),
 			DisplayOnConsentScreen:                types.KeycloakBoolQuoted(data.Get("display_on_consent_screen").(bool)),
 			PostLogoutRedirectUris:                types.KeycloakSliceHashDelimited(validPostLogoutRedirectUris),
+			UseJwksUrl:                            types.KeycloakBoolQuoted(data.Get("use_jwks_url").(bool)),
+			JwksUrl:                               data.Get("jwks_url").(string),
+			TokenEndpointAuthSigningAlg:           data.Get("token_endpoint_auth_signing_alg").(string),
+			TlsClientCertificateBoundAccessTokens: types.KeycloakBoolQuoted(data.Get("tls_client_certificate_bound_access_tokens").(bool)),
+			X509SubjectDn:                         data.Get("x509_subject_dn").(string),
+			X509AllowRegexPatternComparison:       types.KeycloakBoolQuoted(data.Get("x509_allow_regex_pattern_comparison").(bool)),
+			RequirePushedAuthorizationRequests:    types.KeycloakBoolQuoted(data.Get("require_pushed_authorization_requests").(bool)),
+			CibaGrantEnabled:                      types.KeycloakBoolQuoted(data.Get("ciba_grant_enabled").(bool)),
+			CibaBackchannelTokenDeliveryMode:      data.Get("ciba_backchannel_token_delivery_mode").(string),
+			CibaBackchannelClientNotificationUrl:  data.Get("ciba_backchannel_client_notification_endpoint").(string),
+			DpopBoundAccessTokens:                 types.KeycloakBoolQuoted(data.Get("dpop_bound_access_tokens").(bool)),
+			IdTokenSignedResponseAlg:              data.Get("id_token_signed_response_alg").(string),
+			IdTokenEncryptedResponseAlg:           data.Get("id_token_encrypted_response_alg").(string),
+			IdTokenEncryptedResponseEnc:           data.Get("id_token_encrypted_response_enc").(string),
+			UserInfoResponseSignatureAlg:          data.Get("user_info_response_signature_alg").(string),
+			UserInfoEncryptedResponseAlg:          data.Get("user_info_encrypted_response_alg").(string),
+			UserInfoEncryptedResponseEnc:          data.Get("user_info_encrypted_response_enc").(string),
+			AuthorizationSignedResponseAlg:        data.Get("authorization_signed_response_alg").(string),
+			AuthorizationEncryptedResponseAlg:     data.Get("authorization_encrypted_response_alg").(string),
+			AuthorizationEncryptedResponseEnc:     data.Get("authorization_encrypted_response_enc").(string),
+			DefaultAcrValues:                      types.KeycloakSliceHashDelimited(interfaceSliceToStringSlice(data.Get("default_acr_values").([]interface{}))),
+			StandardTokenExchangeEnabled:          types.KeycloakBoolQuoted(data.Get("standard_token_exchange_enabled").(bool)),
+			StandardTokenExchangeRefreshTokenType: data.Get("standard_token_exchange_refresh_token_type").(string),
 		},
 		ValidRedirectUris: validRedirectUris,
 		WebOrigins:        webOrigins,
@@ -385,6 +560,17 @@ func getOpenidClientFromData(data *schema.ResourceData) (*keycloak.OpenidClient,
 		ConsentRequired:   data.Get("consent_required").(bool),
 	}
 
+	acrLoaMap, err := getOpenidClientAcrLoaMapFromData(data)
+	if err != nil {
+		return nil, err
+	}
+	openidClient.Attributes.AcrLoaMap = acrLoaMap
+
+	err = applyOpenidClientLegacyExtraConfig(data, &openidClient.Attributes)
+	if err != nil {
+		return nil, err
+	}
+
 	if rootUrlOk {
 		openidClient.RootUrl = &rootUrlString
 	}
@@ -408,6 +594,14 @@ func getOpenidClientFromData(data *schema.ResourceData) (*keycloak.OpenidClient,
 		openidClient.BearerOnly = true
 	}
 
+	if openidClient.PublicClient {
+		_, rotationTriggerOk := data.GetOk("rotation_trigger")
+		_, rotateAfterOk := data.GetOk("rotate_after")
+		if rotationTriggerOk || rotateAfterOk {
+			return nil, errors.New("rotation_trigger and rotate_after cannot be set for clients with an access_type of PUBLIC")
+		}
+	}
+
 	if v, ok := data.GetOk("authorization"); ok {
 		openidClient.AuthorizationServicesEnabled = true
 		authorizationSettingsData := v.(*schema.Set).List()[0]
@@ -435,6 +629,11 @@ func getOpenidClientFromData(data *schema.ResourceData) (*keycloak.OpenidClient,
 }
 
 func setOpenidClientData(ctx context.Context, keycloakClient *keycloak.KeycloakClient, data *schema.ResourceData, client *keycloak.OpenidClient) error {
+	legacyAttributes, err := setOpenidClientLegacyExtraConfig(data, &client.Attributes)
+	if err != nil {
+		return err
+	}
+
 	var serviceAccountUserId string
 	if client.ServiceAccountsEnabled {
 		serviceAccountUser, err := keycloakClient.GetOpenidClientServiceAccountUserId(ctx, client.RealmId, client.Id)
@@ -482,6 +681,39 @@ func setOpenidClientData(ctx context.Context, keycloakClient *keycloak.KeycloakC
 	data.Set("backchannel_logout_url", client.Attributes.BackchannelLogoutUrl)
 	data.Set("backchannel_logout_revoke_offline_sessions", client.Attributes.BackchannelLogoutRevokeOfflineTokens)
 	data.Set("backchannel_logout_session_required", client.Attributes.BackchannelLogoutSessionRequired)
+	data.Set("use_jwks_url", client.Attributes.UseJwksUrl)
+	data.Set("jwks_url", client.Attributes.JwksUrl)
+	data.Set("token_endpoint_auth_signing_alg", client.Attributes.TokenEndpointAuthSigningAlg)
+	data.Set("tls_client_certificate_bound_access_tokens", client.Attributes.TlsClientCertificateBoundAccessTokens)
+	data.Set("x509_subject_dn", client.Attributes.X509SubjectDn)
+	data.Set("x509_allow_regex_pattern_comparison", client.Attributes.X509AllowRegexPatternComparison)
+	data.Set("require_pushed_authorization_requests", client.Attributes.RequirePushedAuthorizationRequests)
+	data.Set("ciba_grant_enabled", client.Attributes.CibaGrantEnabled)
+	data.Set("ciba_backchannel_token_delivery_mode", client.Attributes.CibaBackchannelTokenDeliveryMode)
+	data.Set("ciba_backchannel_client_notification_endpoint", client.Attributes.CibaBackchannelClientNotificationUrl)
+	data.Set("dpop_bound_access_tokens", client.Attributes.DpopBoundAccessTokens)
+	data.Set("id_token_signed_response_alg", client.Attributes.IdTokenSignedResponseAlg)
+	data.Set("id_token_encrypted_response_alg", client.Attributes.IdTokenEncryptedResponseAlg)
+	data.Set("id_token_encrypted_response_enc", client.Attributes.IdTokenEncryptedResponseEnc)
+	data.Set("user_info_response_signature_alg", client.Attributes.UserInfoResponseSignatureAlg)
+	data.Set("user_info_encrypted_response_alg", client.Attributes.UserInfoEncryptedResponseAlg)
+	data.Set("user_info_encrypted_response_enc", client.Attributes.UserInfoEncryptedResponseEnc)
+	data.Set("authorization_signed_response_alg", client.Attributes.AuthorizationSignedResponseAlg)
+	data.Set("authorization_encrypted_response_alg", client.Attributes.AuthorizationEncryptedResponseAlg)
+	data.Set("authorization_encrypted_response_enc", client.Attributes.AuthorizationEncryptedResponseEnc)
+	data.Set("default_acr_values", client.Attributes.DefaultAcrValues)
+	data.Set("standard_token_exchange_enabled", client.Attributes.StandardTokenExchangeEnabled)
+	data.Set("standard_token_exchange_refresh_token_type", client.Attributes.StandardTokenExchangeRefreshTokenType)
+
+	acrLoaMap, err := flattenOpenidClientAcrLoaMap(client.Attributes.AcrLoaMap)
+	if err != nil {
+		return err
+	}
+	data.Set("acr_loa_map", acrLoaMap)
+
+	for _, attribute := range legacyAttributes {
+		data.Set(attribute, nil)
+	}
 	setExtraConfigData(data, client.Attributes.ExtraConfig)
 
 	if client.AuthorizationServicesEnabled {
@@ -573,6 +805,11 @@ func resourceKeycloakOpenidClientRead(ctx context.Context, data *schema.Resource
 		return diag.FromErr(err)
 	}
 
+	err = setOpenidClientSecretRotationData(ctx, keycloakClient, data, client)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	return nil
 }
 
@@ -589,6 +826,9 @@ func resourceKeycloakOpenidClientUpdate(ctx context.Context, data *schema.Resour
 		return diag.FromErr(err)
 	}
 
+	// this has to be checked before the state is updated below
+	rotateSecret := !client.PublicClient && openidClientSecretRotationIsDue(data)
+
 	err = keycloakClient.UpdateOpenidClient(ctx, client)
 	if err != nil {
 		return diag.FromErr(err)
@@ -599,6 +839,18 @@ func resourceKeycloakOpenidClientUpdate(ctx context.Context, data *schema.Resour
 		return diag.FromErr(err)
 	}
 
+	if rotateSecret {
+		_, err = keycloakClient.RegenerateOpenidClientSecret(ctx, client.RealmId, client.Id)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		// used as the creation time of the new secret when keycloak doesn't track it
+		data.Set("client_secret_created_at", time.Now().UTC().Format(time.RFC3339))
+
+		return resourceKeycloakOpenidClientRead(ctx, data, meta)
+	}
+
 	return nil
 }
 
@@ -638,3 +890,203 @@ func resourceKeycloakOpenidClientImport(ctx context.Context, d *schema.ResourceD
 
 	return []*schema.ResourceData{d}, nil
 }
+
+var openidClientSecretRotationAttributes = []string{
+	"client_secret",
+	"client_secret_created_at",
+	"client_secret_expires_at",
+	"client_secret_rotated",
+	"client_secret_rotated_created_at",
+	"client_secret_rotated_expires_at",
+}
+
+func validateOpenidClientSecretRotateAfter(value interface{}, key string) ([]string, []error) {
+	duration, err := time.ParseDuration(value.(string))
+	if err != nil {
+		return nil, []error{fmt.Errorf("validation error: %s is not a valid duration: %s", key, err)}
+	}
+
+	if duration <= 0 {
+		return nil, []error{fmt.Errorf("validation error: %s must be positive", key)}
+	}
+
+	return nil, nil
+}
+
+// openidClientSecretRotationIsDue is shared between the plan and the apply, so it only looks at the previous values of
+// the computed attributes. the secret is rotated when an existing rotation_trigger changes, or when the current secret
+// is older than rotate_after.
+func openidClientSecretRotationIsDue(data interface {
+	Get(string) interface{}
+	GetChange(string) (interface{}, interface{})
+}) bool {
+	oldTrigger, newTrigger := data.GetChange("rotation_trigger")
+	if len(oldTrigger.(map[string]interface{})) != 0 && !reflect.DeepEqual(oldTrigger, newTrigger) {
+		return true
+	}
+
+	rotateAfter, err := time.ParseDuration(data.Get("rotate_after").(string))
+	if err != nil || rotateAfter <= 0 {
+		return false
+	}
+
+	oldCreatedAt, _ := data.GetChange("client_secret_created_at")
+
+	createdAt, err := time.Parse(time.RFC3339, oldCreatedAt.(string))
+	if err != nil {
+		return false
+	}
+
+	return !time.Now().Before(createdAt.Add(rotateAfter))
+}
+
+func openidClientSecretRotationCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" || d.Get("access_type").(string) == "PUBLIC" || !openidClientSecretRotationIsDue(d) {
+		return nil
+	}
+
+	for _, attribute := range openidClientSecretRotationAttributes {
+		err := d.SetNewComputed(attribute)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func openidClientSecretRotationIsConfigured(data *schema.ResourceData) bool {
+	return len(data.Get("rotation_trigger").(map[string]interface{})) != 0 || data.Get("rotate_after").(string) != ""
+}
+
+func formatOpenidClientSecretTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+
+	return t.Format(time.RFC3339)
+}
+
+// getOpenidClientSecretRotationAttributes returns the current and rotated secret of a client, along with their
+// lifetimes. keycloak only tracks these for versions that support secret rotation. the rotated secret takes an extra
+// request, so it's only looked up when includeRotatedSecret is set.
+func getOpenidClientSecretRotationAttributes(ctx context.Context, keycloakClient *keycloak.KeycloakClient, client *keycloak.OpenidClient, includeRotatedSecret bool) (map[string]string, error) {
+	attributes := map[string]string{
+		"client_secret":                    client.ClientSecret,
+		"client_secret_created_at":         formatOpenidClientSecretTime(keycloak.GetOpenidClientSecretTime(client, keycloak.OpenidClientSecretCreationTimeAttribute)),
+		"client_secret_expires_at":         formatOpenidClientSecretTime(keycloak.GetOpenidClientSecretTime(client, keycloak.OpenidClientSecretExpirationTimeAttribute)),
+		"client_secret_rotated":            "",
+		"client_secret_rotated_created_at": "",
+		"client_secret_rotated_expires_at": "",
+	}
+
+	if client.PublicClient || !includeRotatedSecret {
+		return attributes, nil
+	}
+
+	rotatedSecret, err := keycloakClient.GetOpenidClientRotatedSecret(ctx, client.RealmId, client.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if rotatedSecret != nil {
+		attributes["client_secret_rotated"] = rotatedSecret.Value
+		attributes["client_secret_rotated_created_at"] = formatOpenidClientSecretTime(keycloak.GetOpenidClientSecretTime(client, keycloak.OpenidClientRotatedSecretCreationTimeAttribute))
+		attributes["client_secret_rotated_expires_at"] = formatOpenidClientSecretTime(keycloak.GetOpenidClientSecretTime(client, keycloak.OpenidClientRotatedSecretExpirationAttribute))
+	}
+
+	return attributes, nil
+}
+
+func setOpenidClientSecretRotationData(ctx context.Context, keycloakClient *keycloak.KeycloakClient, data *schema.ResourceData, client *keycloak.OpenidClient) error {
+	attributes, err := getOpenidClientSecretRotationAttributes(ctx, keycloakClient, client, openidClientSecretRotationIsConfigured(data))
+	if err != nil {
+		return err
+	}
+
+	// older versions of keycloak don't track when the secret was generated, so the time it was first seen is kept instead
+	if attributes["client_secret_created_at"] == "" && !client.PublicClient {
+		attributes["client_secret_created_at"] = data.Get("client_secret_created_at").(string)
+		if attributes["client_secret_created_at"] == "" {
+			attributes["client_secret_created_at"] = time.Now().UTC().Format(time.RFC3339)
+		}
+	}
+
+	for attribute, value := range attributes {
+		data.Set(attribute, value)
+	}
+
+	return nil
+}
+
+// validateOpenidClientAcrLoaMap rejects levels of authentication that aren't numbers during plan, rather than when the
+// map is encoded for keycloak
+func validateOpenidClientAcrLoaMap(v interface{}, path cty.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for acr, loa := range v.(map[string]interface{}) {
+		if _, err := strconv.Atoi(loa.(string)); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Invalid level of authentication",
+				Detail:   fmt.Sprintf("validation error: the level of authentication for acr value %s must be a number, got %s", acr, loa),
+				AttributePath: append(path, cty.IndexStep{
+					Key: cty.StringVal(acr),
+				}),
+			})
+		}
+	}
+
+	return diags
+}
+
+// getOpenidClientAcrLoaMapFromData encodes acr_loa_map as the json object keycloak expects, with the levels as numbers
+func getOpenidClientAcrLoaMapFromData(data *schema.ResourceData) (string, error) {
+	acrLoaMapData := data.Get("acr_loa_map").(map[string]interface{})
+	if len(acrLoaMapData) == 0 {
+		return "", nil
+	}
+
+	acrLoaMap := make(map[string]int, len(acrLoaMapData))
+	for acr, loa := range acrLoaMapData {
+		level, err := strconv.Atoi(loa.(string))
+		if err != nil {
+			return "", fmt.Errorf("validation error: the level of authentication for acr value %s must be a number, got %s", acr, loa)
+		}
+
+		acrLoaMap[acr] = level
+	}
+
+	encoded, err := json.Marshal(acrLoaMap)
+	if err != nil {
+		return "", err
+	}
+
+	return string(encoded), nil
+}
+
+// flattenOpenidClientAcrLoaMap accepts the levels as numbers or strings, since the admin console stores them as strings
+func flattenOpenidClientAcrLoaMap(value string) (map[string]string, error) {
+	acrLoaMap := map[string]string{}
+	if value == "" {
+		return acrLoaMap, nil
+	}
+
+	var decoded map[string]interface{}
+	if err := json.Unmarshal([]byte(value), &decoded); err != nil {
+		return nil, fmt.Errorf("unable to parse acr.loa.map attribute: %s", err)
+	}
+
+	for acr, loa := range decoded {
+		switch level := loa.(type) {
+		case float64:
+			acrLoaMap[acr] = strconv.Itoa(int(level))
+		case string:
+			acrLoaMap[acr] = level
+		default:
+			return nil, fmt.Errorf("unable to parse acr.loa.map attribute: unexpected level %v for acr value %s", loa, acr)
+		}
+	}
+
+	return acrLoaMap, nil
+}
@@ -14,6 +14,7 @@ func resourceKeycloakOpenidClientDefaultScopes() *schema.Resource {
 		ReadContext:   resourceKeycloakOpenidClientDefaultScopesRead,
 		DeleteContext: resourceKeycloakOpenidClientDefaultScopesDelete,
 		UpdateContext: resourceKeycloakOpenidClientDefaultScopesReconcile,
+		CustomizeDiff: validateOpenidClientDefaultScopesCustomizeDiff,
 		Schema: map[string]*schema.Schema{
 			"realm_id": {
 				Type:     schema.TypeString,
@@ -35,6 +36,25 @@ func resourceKeycloakOpenidClientDefaultScopes() *schema.Resource {
 	}
 }
 
+// validateOpenidClientDefaultScopesCustomizeDiff rejects dynamic scopes at plan time, since keycloak only allows these to
+// be attached as optional scopes. the check is skipped while the scope names aren't known yet.
+func validateOpenidClientDefaultScopesCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	rawConfig := d.GetRawConfig()
+	if rawConfig.IsNull() {
+		return nil
+	}
+
+	if !rawConfig.GetAttr("realm_id").IsKnown() || !rawConfig.GetAttr("default_scopes").IsWhollyKnown() {
+		return nil
+	}
+
+	defaultScopes := interfaceSliceToStringSlice(d.Get("default_scopes").(*schema.Set).List())
+
+	return keycloakClient.ValidateOpenidClientDefaultScopes(ctx, d.Get("realm_id").(string), defaultScopes)
+}
+
 func openidClientDefaultScopesId(realmId string, clientId string) string {
 	return fmt.Sprintf("%s/%s", realmId, clientId)
 }
@@ -284,6 +284,25 @@ func TestAccKeycloakOpenidClientDefaultScopes_validateDuplicateScopeAssignment(t
 	})
 }
 
+func TestAccKeycloakOpenidClientDefaultScopes_validateDynamicScope(t *testing.T) {
+	skipIfDynamicScopesDisabled(t)
+
+	t.Parallel()
+	client := acctest.RandomWithPrefix("tf-acc")
+	clientScope := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakOpenidClientDefaultScopes_dynamicScope(client, clientScope),
+				ExpectError: regexp.MustCompile("validation error: scope .+ is a dynamic scope, which can only be attached to a client as an optional scope"),
+			},
+		},
+	})
+}
+
 func getDefaultClientScopesFromState(resourceName string, s *terraform.State) ([]*keycloak.OpenidClientScope, error) {
 	rs, ok := s.RootModule().Resources[resourceName]
 	if !ok {
@@ -569,3 +588,32 @@ resource "keycloak_openid_client_default_scopes" "default_scopes" {
 }
 	`, testKeycloakOpenidClientOptionalScopes_basic(client, clientScope))
 }
+
+func testKeycloakOpenidClientDefaultScopes_dynamicScope(client, clientScope string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client" "client" {
+	client_id   = "%s"
+	realm_id    = data.keycloak_realm.realm.id
+	access_type = "CONFIDENTIAL"
+}
+
+resource "keycloak_openid_client_scope" "client_scope" {
+	name     = "%s"
+	realm_id = data.keycloak_realm.realm.id
+	dynamic  = true
+}
+
+resource "keycloak_openid_client_default_scopes" "default_scopes" {
+	realm_id       = data.keycloak_realm.realm.id
+	client_id      = keycloak_openid_client.client.id
+	default_scopes = [
+		"profile",
+		keycloak_openid_client_scope.client_scope.name,
+	]
+}
+	`, testAccRealm.Realm, client, clientScope)
+}
@@ -0,0 +1,191 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/charlesderek/terraform-w-keycloak/keycloak"
+)
+
+func resourceKeycloakOpenidClientJwtCredential() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKeycloakOpenidClientJwtCredentialCreate,
+		ReadContext:   resourceKeycloakOpenidClientJwtCredentialRead,
+		UpdateContext: resourceKeycloakOpenidClientJwtCredentialRead,
+		DeleteContext: resourceKeycloakOpenidClientJwtCredentialDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceKeycloakOpenidClientJwtCredentialImport,
+		},
+		Schema: mergeSchemas(map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"client_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"certificate": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"public_key", "jwks"},
+				Description:   "PEM encoded certificate to upload. If certificate, public_key and jwks are all omitted, Keycloak generates a key pair.",
+			},
+			"public_key": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"certificate", "jwks"},
+			},
+			"jwks": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ValidateFunc:  validation.StringIsJSON,
+				ConflictsWith: []string{"certificate", "public_key"},
+			},
+			"kid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"private_key": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The private key generated by Keycloak. Only set when Keycloak generated the key pair.",
+			},
+		}, realmKeystoreCertificateSchema()),
+	}
+}
+
+func openidClientJwtCredentialId(realmId, clientId string) string {
+	return fmt.Sprintf("%s/%s", realmId, clientId)
+}
+
+// keycloak returns the key material as bare base64, so the configured PEM is kept in state as long as it encodes the
+// same value
+func getOpenidClientJwtCredentialValue(data *schema.ResourceData, attribute, value string) string {
+	configured := data.Get(attribute).(string)
+	if configured == "" || value == "" {
+		return value
+	}
+
+	configuredDer, err := decodeRealmKeystorePem(configured)
+	if err != nil {
+		return value
+	}
+
+	der, err := decodeRealmKeystorePem(value)
+	if err != nil || !bytes.Equal(configuredDer, der) {
+		return value
+	}
+
+	return configured
+}
+
+func resourceKeycloakOpenidClientJwtCredentialCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	clientId := data.Get("client_id").(string)
+
+	var certificate *keycloak.ClientCertificate
+	var err error
+
+	if value, ok := data.GetOk("certificate"); ok {
+		certificate, err = keycloakClient.UploadClientCertificate(ctx, realmId, clientId, keycloak.OpenidClientJwtCredentialAttribute, keycloak.ClientCertificateFormatCertificatePem, value.(string))
+	} else if value, ok := data.GetOk("public_key"); ok {
+		certificate, err = keycloakClient.UploadClientCertificate(ctx, realmId, clientId, keycloak.OpenidClientJwtCredentialAttribute, keycloak.ClientCertificateFormatPublicKeyPem, value.(string))
+	} else if value, ok := data.GetOk("jwks"); ok {
+		certificate, err = keycloakClient.UploadClientCertificate(ctx, realmId, clientId, keycloak.OpenidClientJwtCredentialAttribute, keycloak.ClientCertificateFormatJwks, value.(string))
+	} else {
+		certificate, err = keycloakClient.GenerateClientCertificate(ctx, realmId, clientId, keycloak.OpenidClientJwtCredentialAttribute)
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(openidClientJwtCredentialId(realmId, clientId))
+	data.Set("private_key", certificate.PrivateKey)
+
+	return resourceKeycloakOpenidClientJwtCredentialRead(ctx, data, meta)
+}
+
+func resourceKeycloakOpenidClientJwtCredentialRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	clientId := data.Get("client_id").(string)
+
+	certificate, err := keycloakClient.GetClientCertificate(ctx, realmId, clientId, keycloak.OpenidClientJwtCredentialAttribute)
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	// the key material was removed outside of terraform. for a jwks upload, keycloak only keeps the kid.
+	if certificate.Certificate == "" && certificate.PublicKey == "" && certificate.Kid == "" {
+		data.SetId("")
+
+		return nil
+	}
+
+	data.Set("certificate", getOpenidClientJwtCredentialValue(data, "certificate", certificate.Certificate))
+	data.Set("public_key", getOpenidClientJwtCredentialValue(data, "public_key", certificate.PublicKey))
+	data.Set("kid", certificate.Kid)
+
+	if certificate.Certificate == "" {
+		for _, attribute := range []string{"certificate_not_after", "certificate_subject", "certificate_fingerprint_sha256"} {
+			data.Set(attribute, "")
+		}
+
+		return nil
+	}
+
+	parsedCertificate, err := parseRealmKeystoreCertificate(certificate.Certificate)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	for attribute, value := range getRealmKeystoreCertificateAttributes(parsedCertificate) {
+		data.Set(attribute, value)
+	}
+
+	return getRealmKeystoreCertificateExpiryDiagnostics(parsedCertificate, data.Get("certificate_expiry_warning_days").(int))
+}
+
+func resourceKeycloakOpenidClientJwtCredentialDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	clientId := data.Get("client_id").(string)
+
+	err := keycloakClient.DeleteClientCertificate(ctx, realmId, clientId, keycloak.OpenidClientJwtCredentialAttribute)
+	if err != nil && !keycloak.ErrorIs404(err) {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceKeycloakOpenidClientJwtCredentialImport(ctx context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("Invalid import. Supported import formats: {{realmId}}/{{openidClientId}}")
+	}
+
+	d.Set("realm_id", parts[0])
+	d.Set("client_id", parts[1])
+	d.Set("certificate_expiry_warning_days", 30)
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,151 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/charlesderek/terraform-w-keycloak/keycloak"
+)
+
+func TestAccKeycloakOpenidClientJwtCredential_generated(t *testing.T) {
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakOpenidClientJwtCredentialDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOpenidClientJwtCredential_generated(clientId),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakOpenidClientJwtCredentialExists("keycloak_openid_client_jwt_credential.credential"),
+					resource.TestCheckResourceAttrSet("keycloak_openid_client_jwt_credential.credential", "certificate"),
+					resource.TestCheckResourceAttrSet("keycloak_openid_client_jwt_credential.credential", "private_key"),
+					resource.TestCheckResourceAttrSet("keycloak_openid_client_jwt_credential.credential", "kid"),
+					resource.TestCheckResourceAttr("keycloak_openid_client_jwt_credential.credential", "certificate_subject", "CN="+clientId),
+				),
+			},
+			{
+				ResourceName:            "keycloak_openid_client_jwt_credential.credential",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"private_key"},
+			},
+		},
+	})
+}
+
+func TestAccKeycloakOpenidClientJwtCredential_uploadedCertificate(t *testing.T) {
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
+	_, certificate := generateKeyAndCert(2048)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakOpenidClientJwtCredentialDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOpenidClientJwtCredential_uploadedCertificate(clientId, certificate),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakOpenidClientJwtCredentialExists("keycloak_openid_client_jwt_credential.credential"),
+					resource.TestCheckResourceAttr("keycloak_openid_client_jwt_credential.credential", "private_key", ""),
+					resource.TestCheckResourceAttr("keycloak_openid_client_jwt_credential.credential", "certificate_subject", "CN=New Name,O=New Org."),
+					resource.TestMatchResourceAttr("keycloak_openid_client_jwt_credential.credential", "certificate_fingerprint_sha256", regexp.MustCompile("^[0-9a-f]{64}$")),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakOpenidClientJwtCredentialExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		certificate, err := keycloakClient.GetClientCertificate(testCtx, rs.Primary.Attributes["realm_id"], rs.Primary.Attributes["client_id"], keycloak.OpenidClientJwtCredentialAttribute)
+		if err != nil {
+			return err
+		}
+
+		if certificate.Certificate == "" {
+			return fmt.Errorf("expected openid client %s to have a jwt credential certificate", rs.Primary.Attributes["client_id"])
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKeycloakOpenidClientJwtCredentialDestroy() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "keycloak_openid_client_jwt_credential" {
+				continue
+			}
+
+			certificate, err := keycloakClient.GetClientCertificate(testCtx, rs.Primary.Attributes["realm_id"], rs.Primary.Attributes["client_id"], keycloak.OpenidClientJwtCredentialAttribute)
+			if err != nil {
+				// the client is deleted along with the credential
+				if keycloak.ErrorIs404(err) {
+					continue
+				}
+
+				return err
+			}
+
+			if certificate.Certificate != "" {
+				return fmt.Errorf("openid client %s still has a jwt credential certificate", rs.Primary.Attributes["client_id"])
+			}
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakOpenidClientJwtCredential_generated(clientId string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client" "client" {
+	client_id                 = "%s"
+	realm_id                  = data.keycloak_realm.realm.id
+	access_type               = "CONFIDENTIAL"
+	client_authenticator_type = "client-jwt"
+}
+
+resource "keycloak_openid_client_jwt_credential" "credential" {
+	realm_id  = data.keycloak_realm.realm.id
+	client_id = keycloak_openid_client.client.id
+}
+	`, testAccRealm.Realm, clientId)
+}
+
+func testKeycloakOpenidClientJwtCredential_uploadedCertificate(clientId, certificate string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client" "client" {
+	client_id                 = "%s"
+	realm_id                  = data.keycloak_realm.realm.id
+	access_type               = "CONFIDENTIAL"
+	client_authenticator_type = "client-jwt"
+}
+
+resource "keycloak_openid_client_jwt_credential" "credential" {
+	realm_id    = data.keycloak_realm.realm.id
+	client_id   = keycloak_openid_client.client.id
+	certificate = "%s"
+}
+	`, testAccRealm.Realm, clientId, certificate)
+}
@@ -0,0 +1,19 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceKeycloakOpenidClientProtocolMappers() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKeycloakGenericProtocolMappersReconcile("client_id"),
+		ReadContext:   resourceKeycloakGenericProtocolMappersRead("client_id"),
+		UpdateContext: resourceKeycloakGenericProtocolMappersReconcile("client_id"),
+		DeleteContext: resourceKeycloakGenericProtocolMappersDelete("client_id"),
+		// This resource can be imported using {{realmId}}/{{clientId}}
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceKeycloakGenericProtocolMappersImport("client_id", "{{realmId}}/{{clientId}}"),
+		},
+		Schema: genericProtocolMappersSchema("client_id", "Id of the client that owns the protocol mappers"),
+	}
+}
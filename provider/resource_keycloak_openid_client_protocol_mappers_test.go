@@ -0,0 +1,170 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/charlesderek/terraform-w-keycloak/keycloak"
+)
+
+func TestAccKeycloakOpenidClientProtocolMappers_basic(t *testing.T) {
+	t.Parallel()
+
+	clientId := acctest.RandomWithPrefix("tf-acc")
+	resourceName := "keycloak_openid_client_protocol_mappers.mappers"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakOpenidClientDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOpenidClientProtocolMappers(clientId, "department"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakProtocolMappersCount(resourceName, "client_id", 2),
+					resource.TestCheckResourceAttr(resourceName, "mapper.#", "2"),
+				),
+			},
+			{
+				Config: testKeycloakOpenidClientProtocolMappers(clientId, "division"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakProtocolMappersCount(resourceName, "client_id", 2),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "mapper.*", map[string]string{
+						"name":                  "department",
+						"config.user.attribute": "division",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccKeycloakOpenidClientProtocolMappers_removesUnknownMappers(t *testing.T) {
+	t.Parallel()
+
+	clientId := acctest.RandomWithPrefix("tf-acc")
+	resourceName := "keycloak_openid_client_protocol_mappers.mappers"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakOpenidClientDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOpenidClientProtocolMappers(clientId, "department"),
+				Check:  testAccCheckKeycloakProtocolMappersCount(resourceName, "client_id", 2),
+			},
+			{
+				PreConfig: func() {
+					client, err := keycloakClient.GetGenericClientByClientId(testCtx, testAccRealm.Realm, clientId)
+					if err != nil {
+						t.Fatal(err)
+					}
+
+					err = keycloakClient.NewGenericProtocolMapper(testCtx, &keycloak.GenericProtocolMapper{
+						RealmId:        testAccRealm.Realm,
+						ClientId:       client.Id,
+						Name:           "added-in-the-console",
+						Protocol:       "openid-connect",
+						ProtocolMapper: "oidc-hardcoded-claim-mapper",
+						Config: map[string]string{
+							"claim.name":         "foo",
+							"claim.value":        "bar",
+							"access.token.claim": "true",
+						},
+					})
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testKeycloakOpenidClientProtocolMappers(clientId, "department"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakProtocolMappersCount(resourceName, "client_id", 2),
+					resource.TestCheckResourceAttr(resourceName, "mapper.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckKeycloakProtocolMappersCount checks the number of protocol mappers the client or client scope has in
+// keycloak, which also catches mappers that were left behind
+func testAccCheckKeycloakProtocolMappersCount(resourceName, parentAttribute string, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		realmId := rs.Primary.Attributes["realm_id"]
+		parentId := rs.Primary.Attributes[parentAttribute]
+
+		var mappers []*keycloak.GenericProtocolMapper
+		var err error
+		if parentAttribute == "client_id" {
+			mappers, err = keycloakClient.ListGenericProtocolMappers(testCtx, realmId, parentId, "")
+		} else {
+			mappers, err = keycloakClient.ListGenericProtocolMappers(testCtx, realmId, "", parentId)
+		}
+		if err != nil {
+			return err
+		}
+
+		if len(mappers) != count {
+			return fmt.Errorf("expected %s to have %d protocol mappers, got %d", parentId, count, len(mappers))
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakOpenidClientProtocolMappers(clientId, departmentAttribute string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client" "client" {
+	client_id   = "%s"
+	realm_id    = data.keycloak_realm.realm.id
+	access_type = "CONFIDENTIAL"
+}
+
+resource "keycloak_openid_client_protocol_mappers" "mappers" {
+	realm_id  = data.keycloak_realm.realm.id
+	client_id = keycloak_openid_client.client.id
+
+	mapper {
+		name            = "department"
+		protocol_mapper = "oidc-usermodel-attribute-mapper"
+		config = {
+			"user.attribute"       = "%s"
+			"claim.name"           = "department"
+			"jsonType.label"       = "String"
+			"access.token.claim"   = "true"
+			"id.token.claim"       = "true"
+			"userinfo.token.claim" = "true"
+		}
+	}
+
+	mapper {
+		name            = "tenant"
+		protocol_mapper = "oidc-hardcoded-claim-mapper"
+		config = {
+			"claim.name"         = "tenant"
+			"claim.value"        = "acme"
+			"jsonType.label"     = "String"
+			"access.token.claim" = "true"
+		}
+	}
+}
+	`, testAccRealm.Realm, clientId, departmentAttribute)
+}
@@ -0,0 +1,19 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceKeycloakOpenidClientRoleScopeMappings() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKeycloakGenericRoleScopeMappingsReconcile("client_id"),
+		ReadContext:   resourceKeycloakGenericRoleScopeMappingsRead("client_id"),
+		UpdateContext: resourceKeycloakGenericRoleScopeMappingsReconcile("client_id"),
+		DeleteContext: resourceKeycloakGenericRoleScopeMappingsDelete("client_id"),
+		// This resource can be imported using {{realmId}}/{{clientId}}
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceKeycloakGenericRoleScopeMappingsImport("client_id", "{{realmId}}/{{clientId}}"),
+		},
+		Schema: genericRoleScopeMappingsSchema("client_id", "Id of the client the roles are mapped into. The mappings only apply when full_scope_allowed is false on the client."),
+	}
+}
@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccKeycloakOpenidClientRoleScopeMappings_basic(t *testing.T) {
+	t.Parallel()
+
+	clientId := acctest.RandomWithPrefix("tf-acc")
+	roleClientId := acctest.RandomWithPrefix("tf-acc")
+	realmRoleName := acctest.RandomWithPrefix("tf-acc")
+	clientRoleName := acctest.RandomWithPrefix("tf-acc")
+
+	resourceName := "keycloak_openid_client_role_scope_mappings.mappings"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakOpenidClientDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOpenidClientRoleScopeMappings(clientId, roleClientId, realmRoleName, clientRoleName, "keycloak_role.realm_role.id, keycloak_role.client_role.id"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakRoleScopeMappingsMatchState(resourceName, "client_id"),
+					resource.TestCheckResourceAttr(resourceName, "role_ids.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testKeycloakOpenidClientRoleScopeMappings(clientId, roleClientId, realmRoleName, clientRoleName, "keycloak_role.client_role.id"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakRoleScopeMappingsMatchState(resourceName, "client_id"),
+					resource.TestCheckResourceAttr(resourceName, "role_ids.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testKeycloakOpenidClientRoleScopeMappings(clientId, roleClientId, realmRoleName, clientRoleName, roleIds string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client" "client" {
+	client_id          = "%s"
+	realm_id           = data.keycloak_realm.realm.id
+	access_type        = "CONFIDENTIAL"
+	full_scope_allowed = false
+}
+
+resource "keycloak_openid_client" "role_client" {
+	client_id   = "%s"
+	realm_id    = data.keycloak_realm.realm.id
+	access_type = "BEARER-ONLY"
+}
+
+resource "keycloak_role" "realm_role" {
+	realm_id = data.keycloak_realm.realm.id
+	name     = "%s"
+}
+
+resource "keycloak_role" "client_role" {
+	realm_id  = data.keycloak_realm.realm.id
+	client_id = keycloak_openid_client.role_client.id
+	name      = "%s"
+}
+
+resource "keycloak_openid_client_role_scope_mappings" "mappings" {
+	realm_id  = data.keycloak_realm.realm.id
+	client_id = keycloak_openid_client.client.id
+	role_ids  = [%s]
+}
+	`, testAccRealm.Realm, clientId, roleClientId, realmRoleName, clientRoleName, roleIds)
+}
@@ -5,10 +5,12 @@ import (
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/charlesderek/terraform-w-keycloak/keycloak/types"
+	"regexp"
 	"strconv"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/charlesderek/terraform-w-keycloak/keycloak"
 )
 
@@ -22,6 +24,7 @@ func resourceKeycloakOpenidClientScope() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceKeycloakOpenidClientScopeImport,
 		},
+		CustomizeDiff: validateOpenidClientScopeCustomizeDiff,
 		Schema: map[string]*schema.Schema{
 			"realm_id": {
 				Type:     schema.TypeString,
@@ -49,10 +52,58 @@ func resourceKeycloakOpenidClientScope() *schema.Resource {
 				Type:     schema.TypeInt,
 				Optional: true,
 			},
+			"dynamic": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "When true, the client scope matches parameterized scopes, such as group:admins",
+			},
+			"dynamic_scope_regexp": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^\S+:\*$`), "must be a scope followed by :*, such as group:*"),
+				Description:  "The format of the parameterized scopes. Defaults to the name of the client scope followed by :*",
+			},
 		},
 	}
 }
 
+// validateOpenidClientScopeCustomizeDiff checks the dynamic scope settings at plan time, including whether the server
+// has the dynamic-scopes feature enabled
+func validateOpenidClientScopeCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	rawConfig := d.GetRawConfig()
+	if rawConfig.IsNull() {
+		return nil
+	}
+
+	// the default regexp depends on the name, and is removed along with the flag
+	dynamicScopeRegexp := rawConfig.GetAttr("dynamic_scope_regexp")
+	if dynamicScopeRegexp.IsNull() && d.Id() != "" && (d.HasChange("dynamic") || d.HasChange("name")) {
+		if err := d.SetNewComputed("dynamic_scope_regexp"); err != nil {
+			return err
+		}
+	}
+
+	dynamic := rawConfig.GetAttr("dynamic")
+	if !dynamic.IsKnown() || dynamic.IsNull() || dynamic.False() {
+		if dynamic.IsKnown() && dynamicScopeRegexp.IsKnown() && !dynamicScopeRegexp.IsNull() {
+			return fmt.Errorf("validation error: dynamic_scope_regexp can only be set when dynamic is true")
+		}
+
+		return nil
+	}
+
+	clientScope := &keycloak.OpenidClientScope{
+		Name: d.Get("name").(string),
+	}
+	clientScope.Attributes.IsDynamicScope = true
+
+	return keycloakClient.ValidateOpenidClientScope(ctx, clientScope)
+}
+
 func getOpenidClientScopeFromData(data *schema.ResourceData) *keycloak.OpenidClientScope {
 	clientScope := &keycloak.OpenidClientScope{
 		Id:          data.Id(),
@@ -75,6 +126,18 @@ func getOpenidClientScopeFromData(data *schema.ResourceData) *keycloak.OpenidCli
 		clientScope.Attributes.GuiOrder = strconv.Itoa(guiOrder)
 	}
 
+	// keycloak expects the regexp to be removed along with the flag, which is done by sending an empty string
+	if data.Get("dynamic").(bool) {
+		clientScope.Attributes.IsDynamicScope = true
+		clientScope.Attributes.DynamicScopeRegexp = clientScope.Name + ":*"
+
+		if rawConfig := data.GetRawConfig(); !rawConfig.IsNull() {
+			if dynamicScopeRegexp := rawConfig.GetAttr("dynamic_scope_regexp"); !dynamicScopeRegexp.IsNull() {
+				clientScope.Attributes.DynamicScopeRegexp = dynamicScopeRegexp.AsString()
+			}
+		}
+	}
+
 	return clientScope
 }
 
@@ -93,6 +156,9 @@ func setOpenidClientScopeData(data *schema.ResourceData, clientScope *keycloak.O
 	if guiOrder, err := strconv.Atoi(clientScope.Attributes.GuiOrder); err == nil {
 		data.Set("gui_order", guiOrder)
 	}
+
+	data.Set("dynamic", clientScope.Attributes.IsDynamicScope)
+	data.Set("dynamic_scope_regexp", clientScope.Attributes.DynamicScopeRegexp)
 }
 
 func resourceKeycloakOpenidClientScopeCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
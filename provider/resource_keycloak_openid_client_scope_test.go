@@ -3,6 +3,7 @@ package provider
 import (
 	"fmt"
 	"github.com/charlesderek/terraform-w-keycloak/keycloak/types"
+	"regexp"
 	"strconv"
 	"testing"
 
@@ -177,6 +178,83 @@ func TestAccKeycloakClientScope_guiOrder(t *testing.T) {
 	})
 }
 
+func TestAccKeycloakClientScope_dynamic(t *testing.T) {
+	skipIfDynamicScopesDisabled(t)
+
+	t.Parallel()
+	clientScopeName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakClientScopeDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakClientScope_dynamic(clientScopeName, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("keycloak_openid_client_scope.client_scope", "dynamic", "true"),
+					resource.TestCheckResourceAttr("keycloak_openid_client_scope.client_scope", "dynamic_scope_regexp", clientScopeName+":*"),
+				),
+			},
+			{
+				Config: testKeycloakClientScope_dynamic(clientScopeName, "group:*"),
+				Check:  resource.TestCheckResourceAttr("keycloak_openid_client_scope.client_scope", "dynamic_scope_regexp", "group:*"),
+			},
+			{
+				ResourceName:        "keycloak_openid_client_scope.client_scope",
+				ImportState:         true,
+				ImportStateVerify:   true,
+				ImportStateIdPrefix: testAccRealm.Realm + "/",
+			},
+			{
+				Config: testKeycloakClientScope_basic(clientScopeName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("keycloak_openid_client_scope.client_scope", "dynamic", "false"),
+					resource.TestCheckResourceAttr("keycloak_openid_client_scope.client_scope", "dynamic_scope_regexp", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakClientScope_dynamicValidation(t *testing.T) {
+	t.Parallel()
+	clientScopeName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakClientScopeDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakClientScope_dynamicRegexpOnly(clientScopeName, "group:*"),
+				ExpectError: regexp.MustCompile("validation error: dynamic_scope_regexp can only be set when dynamic is true"),
+			},
+			{
+				Config:      testKeycloakClientScope_dynamic(clientScopeName, "group"),
+				ExpectError: regexp.MustCompile("must be a scope followed by :\\*"),
+			},
+		},
+	})
+}
+
+// skipIfDynamicScopesDisabled skips tests that need the dynamic-scopes feature, which is a preview feature that isn't
+// enabled by default
+func skipIfDynamicScopesDisabled(t *testing.T) {
+	if ok, _ := keycloakClient.VersionIsGreaterThanOrEqualTo(testCtx, keycloak.Version_18); !ok {
+		t.Skip()
+	}
+
+	serverInfo, err := keycloakClient.GetServerInfo(testCtx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !serverInfo.FeatureIsEnabled("DYNAMIC_SCOPES") {
+		t.Skip("the dynamic-scopes feature is not enabled")
+	}
+}
+
 func testAccCheckKeycloakClientScopeExistsWithCorrectProtocol(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		clientScope, err := getClientScopeFromState(s, resourceName)
@@ -389,3 +467,41 @@ resource "keycloak_openid_client_scope" "client_scope" {
 }
 	`, testAccRealm.Realm, testAccRealmTwo.Realm, clientScopeName)
 }
+
+func testKeycloakClientScope_dynamic(clientScopeName, dynamicScopeRegexp string) string {
+	regexpAttribute := ""
+	if dynamicScopeRegexp != "" {
+		regexpAttribute = fmt.Sprintf("dynamic_scope_regexp = \"%s\"", dynamicScopeRegexp)
+	}
+
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client_scope" "client_scope" {
+	name        = "%s"
+	realm_id    = data.keycloak_realm.realm.id
+	description = "test description"
+
+	dynamic = true
+	%s
+}
+	`, testAccRealm.Realm, clientScopeName, regexpAttribute)
+}
+
+func testKeycloakClientScope_dynamicRegexpOnly(clientScopeName, dynamicScopeRegexp string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client_scope" "client_scope" {
+	name        = "%s"
+	realm_id    = data.keycloak_realm.realm.id
+	description = "test description"
+
+	dynamic_scope_regexp = "%s"
+}
+	`, testAccRealm.Realm, clientScopeName, dynamicScopeRegexp)
+}
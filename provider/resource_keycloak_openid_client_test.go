@@ -3,6 +3,7 @@ package provider
 import (
 	"fmt"
 	"github.com/charlesderek/terraform-w-keycloak/keycloak/types"
+	"reflect"
 	"regexp"
 	"strings"
 	"testing"
@@ -433,6 +434,277 @@ func TestAccKeycloakOpenidClient_secret(t *testing.T) {
 	})
 }
 
+func TestAccKeycloakOpenidClient_secretRotationTrigger(t *testing.T) {
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
+
+	var previousSecret string
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakOpenidClientDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOpenidClient_secretRotationTrigger(clientId, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakOpenidClientHasNonEmptyClientSecret("keycloak_openid_client.client"),
+					resource.TestCheckResourceAttrSet("keycloak_openid_client.client", "client_secret_created_at"),
+					testAccCheckKeycloakOpenidClientSecretFetch("keycloak_openid_client.client", &previousSecret),
+				),
+			},
+			{
+				// changing the trigger generates a new secret
+				Config: testKeycloakOpenidClient_secretRotationTrigger(clientId, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakOpenidClientSecretRotated("keycloak_openid_client.client", &previousSecret),
+					testAccCheckKeycloakOpenidClientSecretFetch("keycloak_openid_client.client", &previousSecret),
+				),
+			},
+			{
+				// an unchanged trigger keeps the secret
+				Config:   testKeycloakOpenidClient_secretRotationTrigger(clientId, "2"),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccKeycloakOpenidClient_secretRotationValidation(t *testing.T) {
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakOpenidClientDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakOpenidClient_secretRotateAfter(clientId, "PUBLIC", "720h"),
+				ExpectError: regexp.MustCompile("rotation_trigger and rotate_after cannot be set for clients with an access_type of PUBLIC"),
+			},
+			{
+				Config:      testKeycloakOpenidClient_secretRotateAfter(clientId, "CONFIDENTIAL", "0s"),
+				ExpectError: regexp.MustCompile("validation error: rotate_after must be positive"),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakOpenidClient_jwtAuthentication(t *testing.T) {
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakOpenidClientDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOpenidClient_jwtAuthentication(clientId, "client-jwt", "PS256", "https://example.com/jwks"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakOpenidClientJwtAuthentication("keycloak_openid_client.client", "client-jwt", "PS256", "https://example.com/jwks"),
+				),
+			},
+			{
+				Config: testKeycloakOpenidClient_jwtAuthentication(clientId, "client-secret-jwt", "HS512", ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakOpenidClientJwtAuthentication("keycloak_openid_client.client", "client-secret-jwt", "HS512", ""),
+				),
+			},
+			{
+				ResourceName:        "keycloak_openid_client.client",
+				ImportState:         true,
+				ImportStateVerify:   true,
+				ImportStateIdPrefix: testAccRealm.Realm + "/",
+			},
+		},
+	})
+}
+
+func TestAccKeycloakOpenidClient_x509Authentication(t *testing.T) {
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakOpenidClientDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakOpenidClient_x509Authentication(clientId, ""),
+				ExpectError: regexp.MustCompile("validation error: x509 client authentication requires a subject DN"),
+			},
+			{
+				Config: testKeycloakOpenidClient_x509Authentication(clientId, "CN=(.*)\\\\.example\\\\.com"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("keycloak_openid_client.client", "x509_subject_dn", "CN=(.*)\\.example\\.com"),
+					testAccCheckKeycloakOpenidClientX509Authentication("keycloak_openid_client.client", "CN=(.*)\\.example\\.com"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakOpenidClient_x509AuthenticationLegacyExtraConfig(t *testing.T) {
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakOpenidClientDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOpenidClient_x509AuthenticationLegacyExtraConfig(clientId, "CN=legacy.example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("keycloak_openid_client.client", "x509_subject_dn", ""),
+					resource.TestCheckResourceAttr("keycloak_openid_client.client", "extra_config.x509.subjectdn", "CN=legacy.example.com"),
+					testAccCheckKeycloakOpenidClientX509Authentication("keycloak_openid_client.client", "CN=legacy.example.com"),
+				),
+			},
+			{
+				// moving the value to the attribute doesn't change the client
+				Config: testKeycloakOpenidClient_x509Authentication(clientId, "CN=legacy.example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("keycloak_openid_client.client", "x509_subject_dn", "CN=legacy.example.com"),
+					resource.TestCheckNoResourceAttr("keycloak_openid_client.client", "extra_config.x509.subjectdn"),
+					testAccCheckKeycloakOpenidClientX509Authentication("keycloak_openid_client.client", "CN=legacy.example.com"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakOpenidClient_jwtAuthenticationValidation(t *testing.T) {
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakOpenidClientDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakOpenidClient_jwtAuthentication(clientId, "client-secret-jwt", "RS256", ""),
+				ExpectError: regexp.MustCompile("validation error: client-secret-jwt authentication requires an HMAC signing algorithm"),
+			},
+			{
+				Config:      testKeycloakOpenidClient_jwtAuthentication(clientId, "client-jwt", "HS256", ""),
+				ExpectError: regexp.MustCompile("validation error: client-jwt authentication requires an asymmetric signing algorithm"),
+			},
+			{
+				Config:      testKeycloakOpenidClient_useJwksUrlWithoutUrl(clientId),
+				ExpectError: regexp.MustCompile("validation error: a JWKS url is required"),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakOpenidClient_advancedProtocolSettings(t *testing.T) {
+	if ok, _ := keycloakClient.VersionIsGreaterThanOrEqualTo(testCtx, keycloak.Version_23); !ok {
+		t.Skip()
+	}
+
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakOpenidClientDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOpenidClient_advancedProtocolSettings(clientId),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakOpenidClientAdvancedProtocolSettings("keycloak_openid_client.client"),
+					resource.TestCheckResourceAttr("keycloak_openid_client.client", "acr_loa_map.silver", "1"),
+					resource.TestCheckResourceAttr("keycloak_openid_client.client", "acr_loa_map.gold", "2"),
+					resource.TestCheckResourceAttr("keycloak_openid_client.client", "default_acr_values.#", "2"),
+				),
+			},
+			{
+				ResourceName:        "keycloak_openid_client.client",
+				ImportState:         true,
+				ImportStateVerify:   true,
+				ImportStateIdPrefix: testAccRealm.Realm + "/",
+			},
+			{
+				Config: testKeycloakOpenidClient_protocolSetting(clientId, `acr_loa_map = { silver = "1" }`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("keycloak_openid_client.client", "acr_loa_map.%", "1"),
+					resource.TestCheckResourceAttr("keycloak_openid_client.client", "default_acr_values.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakOpenidClient_advancedProtocolSettingsValidation(t *testing.T) {
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakOpenidClientDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakOpenidClient_protocolSetting(clientId, `id_token_signed_response_alg = "RS1024"`),
+				ExpectError: regexp.MustCompile(`validation error: ID token signature algorithm "RS1024" is not supported by the server`),
+			},
+			{
+				Config:      testKeycloakOpenidClient_protocolSetting(clientId, `id_token_encrypted_response_enc = "A256GCM"`),
+				ExpectError: regexp.MustCompile("validation error: the ID token content encryption algorithm can only be set along with a key management algorithm"),
+			},
+			{
+				Config:      testKeycloakOpenidClient_protocolSetting(clientId, `acr_loa_map = { gold = "high" }`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("validation error: the level of authentication for acr value gold must be a number"),
+			},
+			{
+				Config:      testKeycloakOpenidClient_protocolSetting(clientId, "ciba_grant_enabled = true\n\tciba_backchannel_token_delivery_mode = \"ping\""),
+				ExpectError: regexp.MustCompile("validation error: the ping token delivery mode requires a client notification endpoint"),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakOpenidClient_standardTokenExchange(t *testing.T) {
+	if ok, _ := keycloakClient.VersionIsGreaterThanOrEqualTo(testCtx, keycloak.Version_26_2); !ok {
+		t.Skip()
+	}
+
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakOpenidClientDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOpenidClient_protocolSetting(clientId, "standard_token_exchange_enabled = true\n\tstandard_token_exchange_refresh_token_type = \"SAME_SESSION\""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("keycloak_openid_client.client", "standard_token_exchange_enabled", "true"),
+					resource.TestCheckResourceAttr("keycloak_openid_client.client", "standard_token_exchange_refresh_token_type", "SAME_SESSION"),
+				),
+			},
+			{
+				Config: testKeycloakOpenidClient_protocolSetting(clientId, "standard_token_exchange_enabled = false"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("keycloak_openid_client.client", "standard_token_exchange_enabled", "false"),
+					resource.TestCheckResourceAttr("keycloak_openid_client.client", "standard_token_exchange_refresh_token_type", ""),
+				),
+			},
+			{
+				Config:      testKeycloakOpenidClient_protocolSetting(clientId, "standard_token_exchange_refresh_token_type = \"NO\""),
+				ExpectError: regexp.MustCompile("validation error: the refresh token behavior of standard token exchange can only be set when standard token exchange is enabled"),
+			},
+		},
+	})
+}
+
 func TestAccKeycloakOpenidClient_redirectUrisValidation(t *testing.T) {
 	t.Parallel()
 	clientId := acctest.RandomWithPrefix("tf-acc")
@@ -1016,6 +1288,140 @@ func testAccCheckKeycloakOpenidClientHasNonEmptyClientSecret(resourceName string
 	}
 }
 
+func testAccCheckKeycloakOpenidClientSecretFetch(resourceName string, secret *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client, err := getOpenidClientFromState(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		*secret = client.ClientSecret
+
+		return nil
+	}
+}
+
+func testAccCheckKeycloakOpenidClientSecretRotated(resourceName string, previousSecret *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client, err := getOpenidClientFromState(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		if client.ClientSecret == "" || client.ClientSecret == *previousSecret {
+			return fmt.Errorf("expected openid client %s to have a new secret", client.ClientId)
+		}
+
+		if stateSecret := s.RootModule().Resources[resourceName].Primary.Attributes["client_secret"]; stateSecret != client.ClientSecret {
+			return fmt.Errorf("expected the client_secret in state to match the new secret of openid client %s", client.ClientId)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKeycloakOpenidClientJwtAuthentication(resourceName, authenticatorType, signingAlg, jwksUrl string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client, err := getOpenidClientFromState(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		if client.ClientAuthenticatorType != authenticatorType {
+			return fmt.Errorf("expected openid client to have authenticator type %s, but got %s", authenticatorType, client.ClientAuthenticatorType)
+		}
+
+		if client.Attributes.TokenEndpointAuthSigningAlg != signingAlg {
+			return fmt.Errorf("expected openid client to have token endpoint auth signing alg %s, but got %s", signingAlg, client.Attributes.TokenEndpointAuthSigningAlg)
+		}
+
+		if bool(client.Attributes.UseJwksUrl) != (jwksUrl != "") {
+			return fmt.Errorf("expected openid client to have use jwks url set to %t, but got %t", jwksUrl != "", client.Attributes.UseJwksUrl)
+		}
+
+		if client.Attributes.JwksUrl != jwksUrl {
+			return fmt.Errorf("expected openid client to have jwks url %s, but got %s", jwksUrl, client.Attributes.JwksUrl)
+		}
+
+		if !client.Attributes.TlsClientCertificateBoundAccessTokens {
+			return fmt.Errorf("expected openid client to have tls client certificate bound access tokens enabled")
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKeycloakOpenidClientX509Authentication(resourceName, subjectDn string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client, err := getOpenidClientFromState(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		if client.Attributes.X509SubjectDn != subjectDn {
+			return fmt.Errorf("expected openid client to have x509 subject dn %s, but got %s", subjectDn, client.Attributes.X509SubjectDn)
+		}
+
+		if !client.Attributes.X509AllowRegexPatternComparison {
+			return fmt.Errorf("expected openid client to allow regex pattern comparison for the x509 subject dn")
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKeycloakOpenidClientAdvancedProtocolSettings(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client, err := getOpenidClientFromState(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		if !client.Attributes.RequirePushedAuthorizationRequests {
+			return fmt.Errorf("expected openid client to require pushed authorization requests")
+		}
+
+		if !client.Attributes.DpopBoundAccessTokens {
+			return fmt.Errorf("expected openid client to require DPoP bound access tokens")
+		}
+
+		if !client.Attributes.CibaGrantEnabled || client.Attributes.CibaBackchannelTokenDeliveryMode != "poll" {
+			return fmt.Errorf("expected openid client to have the CIBA grant enabled with the poll delivery mode")
+		}
+
+		if client.Attributes.IdTokenSignedResponseAlg != "PS256" {
+			return fmt.Errorf("expected openid client to have id token signed response alg PS256, but got %s", client.Attributes.IdTokenSignedResponseAlg)
+		}
+
+		if client.Attributes.IdTokenEncryptedResponseAlg != "RSA-OAEP" || client.Attributes.IdTokenEncryptedResponseEnc != "A256GCM" {
+			return fmt.Errorf("expected openid client to encrypt id tokens with RSA-OAEP and A256GCM, but got %s and %s", client.Attributes.IdTokenEncryptedResponseAlg, client.Attributes.IdTokenEncryptedResponseEnc)
+		}
+
+		if client.Attributes.AuthorizationSignedResponseAlg != "PS256" {
+			return fmt.Errorf("expected openid client to have authorization signed response alg PS256, but got %s", client.Attributes.AuthorizationSignedResponseAlg)
+		}
+
+		if client.Attributes.UserInfoResponseSignatureAlg != "unsigned" {
+			return fmt.Errorf("expected openid client to have unsigned user info responses, but got %s", client.Attributes.UserInfoResponseSignatureAlg)
+		}
+
+		acrLoaMap, err := flattenOpenidClientAcrLoaMap(client.Attributes.AcrLoaMap)
+		if err != nil {
+			return err
+		}
+
+		if !reflect.DeepEqual(acrLoaMap, map[string]string{"silver": "1", "gold": "2"}) {
+			return fmt.Errorf("expected openid client to have acr loa map silver=1 and gold=2, but got %s", client.Attributes.AcrLoaMap)
+		}
+
+		if !reflect.DeepEqual([]string(client.Attributes.DefaultAcrValues), []string{"silver", "gold"}) {
+			return fmt.Errorf("expected openid client to have default acr values silver and gold, but got %v", client.Attributes.DefaultAcrValues)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckKeycloakOpenidClientDestroy() resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		for _, rs := range s.RootModule().Resources {
@@ -1533,6 +1939,161 @@ resource "keycloak_openid_client" "client" {
 	`, testAccRealm.Realm, clientId, clientSecret)
 }
 
+func testKeycloakOpenidClient_secretRotationTrigger(clientId, trigger string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client" "client" {
+	client_id   = "%s"
+	realm_id    = data.keycloak_realm.realm.id
+	access_type = "CONFIDENTIAL"
+
+	rotation_trigger = {
+		version = "%s"
+	}
+}
+	`, testAccRealm.Realm, clientId, trigger)
+}
+
+func testKeycloakOpenidClient_secretRotateAfter(clientId, accessType, rotateAfter string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client" "client" {
+	client_id    = "%s"
+	realm_id     = data.keycloak_realm.realm.id
+	access_type  = "%s"
+	rotate_after = "%s"
+}
+	`, testAccRealm.Realm, clientId, accessType, rotateAfter)
+}
+
+func testKeycloakOpenidClient_jwtAuthentication(clientId, authenticatorType, signingAlg, jwksUrl string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client" "client" {
+	client_id                                  = "%s"
+	realm_id                                   = data.keycloak_realm.realm.id
+	access_type                                = "CONFIDENTIAL"
+	client_authenticator_type                  = "%s"
+	token_endpoint_auth_signing_alg            = "%s"
+	use_jwks_url                               = %t
+	jwks_url                                   = "%s"
+	tls_client_certificate_bound_access_tokens = true
+}
+	`, testAccRealm.Realm, clientId, authenticatorType, signingAlg, jwksUrl != "", jwksUrl)
+}
+
+func testKeycloakOpenidClient_useJwksUrlWithoutUrl(clientId string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client" "client" {
+	client_id                 = "%s"
+	realm_id                  = data.keycloak_realm.realm.id
+	access_type               = "CONFIDENTIAL"
+	client_authenticator_type = "client-jwt"
+	use_jwks_url              = true
+}
+	`, testAccRealm.Realm, clientId)
+}
+
+func testKeycloakOpenidClient_x509Authentication(clientId, subjectDn string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client" "client" {
+	client_id                           = "%s"
+	realm_id                            = data.keycloak_realm.realm.id
+	access_type                         = "CONFIDENTIAL"
+	client_authenticator_type           = "client-x509"
+	x509_subject_dn                     = "%s"
+	x509_allow_regex_pattern_comparison = true
+}
+	`, testAccRealm.Realm, clientId, subjectDn)
+}
+
+func testKeycloakOpenidClient_x509AuthenticationLegacyExtraConfig(clientId, subjectDn string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client" "client" {
+	client_id                 = "%s"
+	realm_id                  = data.keycloak_realm.realm.id
+	access_type               = "CONFIDENTIAL"
+	client_authenticator_type = "client-x509"
+
+	extra_config = {
+		"x509.subjectdn"                      = "%s"
+		"x509.allow.regex.pattern.comparison" = "true"
+	}
+}
+	`, testAccRealm.Realm, clientId, subjectDn)
+}
+
+func testKeycloakOpenidClient_advancedProtocolSettings(clientId string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client" "client" {
+	client_id                = "%s"
+	realm_id                 = data.keycloak_realm.realm.id
+	access_type              = "CONFIDENTIAL"
+	service_accounts_enabled = true
+
+	require_pushed_authorization_requests = true
+	dpop_bound_access_tokens              = true
+
+	ciba_grant_enabled                   = true
+	ciba_backchannel_token_delivery_mode = "poll"
+
+	id_token_signed_response_alg     = "PS256"
+	id_token_encrypted_response_alg  = "RSA-OAEP"
+	id_token_encrypted_response_enc  = "A256GCM"
+	user_info_response_signature_alg = "unsigned"
+
+	authorization_signed_response_alg = "PS256"
+
+	acr_loa_map = {
+		silver = "1"
+		gold   = "2"
+	}
+	default_acr_values = ["silver", "gold"]
+}
+	`, testAccRealm.Realm, clientId)
+}
+
+func testKeycloakOpenidClient_protocolSetting(clientId, setting string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client" "client" {
+	client_id   = "%s"
+	realm_id    = data.keycloak_realm.realm.id
+	access_type = "CONFIDENTIAL"
+
+	%s
+}
+	`, testAccRealm.Realm, clientId, setting)
+}
+
 func testKeycloakOpenidClient_invalidRedirectUris(clientId, accessType string, standardFlowEnabled, implicitFlowEnabled bool) string {
 	return fmt.Sprintf(`
 data "keycloak_realm" "realm" {
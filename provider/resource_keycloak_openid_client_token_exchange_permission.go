@@ -0,0 +1,315 @@
+package provider
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/rand"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/charlesderek/terraform-w-keycloak/keycloak"
+)
+
+func resourceKeycloakOpenidClientTokenExchangePermission() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKeycloakOpenidClientTokenExchangePermissionUpdate,
+		ReadContext:   resourceKeycloakOpenidClientTokenExchangePermissionRead,
+		DeleteContext: resourceKeycloakOpenidClientTokenExchangePermissionDelete,
+		UpdateContext: resourceKeycloakOpenidClientTokenExchangePermissionUpdate,
+		// This resource can be imported using {{realmId}}/{{clientId}}, where clientId is the id of the audience client
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceKeycloakOpenidClientTokenExchangePermissionImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"client_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Id of the client whose audience the clients are allowed to exchange tokens for",
+			},
+			"clients": {
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Required:    true,
+				Description: "Ids of the clients that are allowed to exchange tokens for the audience of client_id",
+			},
+			"policy_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"authorization_resource_server_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"authorization_token_exchange_scope_permission_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// getOpenidClientTokenExchangePermission returns the "token-exchange" scope permission that keycloak creates in the
+// realm-management client when permissions are enabled for a client
+func getOpenidClientTokenExchangePermission(ctx context.Context, keycloakClient *keycloak.KeycloakClient, realmId, clientId string) (*keycloak.OpenidClientAuthorizationPermission, error) {
+	clientPermissions, err := keycloakClient.GetOpenidClientPermissions(ctx, realmId, clientId)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenExchangePermissionId, ok := clientPermissions.ScopePermissions["token-exchange"]
+	if !ok {
+		return nil, fmt.Errorf("client %s has no token-exchange scope permission, make sure the token exchange feature is enabled", clientId)
+	}
+
+	realmManagementClient, err := keycloakClient.GetOpenidClientByClientId(ctx, realmId, "realm-management")
+	if err != nil {
+		return nil, err
+	}
+
+	return keycloakClient.GetOpenidClientAuthorizationPermission(ctx, realmId, realmManagementClient.Id, tokenExchangePermissionId)
+}
+
+func createOpenidClientTokenExchangeClientPolicy(ctx context.Context, keycloakClient *keycloak.KeycloakClient, realmId, resourceServerId, clientId string, clients []string) (string, error) {
+	policy := &keycloak.OpenidClientAuthorizationClientPolicy{
+		RealmId:          realmId,
+		ResourceServerId: resourceServerId,
+		Name:             clientId + "_token_exchange_client_policy",
+		DecisionStrategy: "UNANIMOUS",
+		Logic:            "POSITIVE",
+		Type:             "client",
+		Clients:          clients,
+	}
+
+	err := keycloakClient.NewOpenidClientAuthorizationClientPolicy(ctx, policy)
+	if err != nil && keycloak.ErrorIs409(err) {
+		b := make([]byte, 4)
+		rand.Read(b)
+		policy.Name = clientId + "_" + hex.EncodeToString(b) + "_token_exchange_client_policy"
+
+		err = keycloakClient.NewOpenidClientAuthorizationClientPolicy(ctx, policy)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	return policy.Id, nil
+}
+
+// isOpenidClientTokenExchangeClientPolicyName returns true for the names createOpenidClientTokenExchangeClientPolicy
+// gives to the policies it creates, so policies that were attached to the permission in some other way are left alone
+func isOpenidClientTokenExchangeClientPolicyName(clientId, name string) bool {
+	suffix := "_token_exchange_client_policy"
+	if name == clientId+suffix {
+		return true
+	}
+
+	randomPart := strings.TrimSuffix(strings.TrimPrefix(name, clientId+"_"), suffix)
+	if len(randomPart) != 8 || name != clientId+"_"+randomPart+suffix {
+		return false
+	}
+
+	_, err := hex.DecodeString(randomPart)
+
+	return err == nil
+}
+
+// findOpenidClientTokenExchangeClientPolicy returns the id of the client policy managed by this resource among the
+// policies of the token-exchange permission, or an empty string if there is none
+func findOpenidClientTokenExchangeClientPolicy(ctx context.Context, keycloakClient *keycloak.KeycloakClient, permission *keycloak.OpenidClientAuthorizationPermission, clientId, policyId string) (string, error) {
+	for _, id := range permission.Policies {
+		if id == policyId {
+			return id, nil
+		}
+	}
+
+	for _, id := range permission.Policies {
+		policy, err := keycloakClient.GetOpenidClientAuthorizationPolicy(ctx, permission.RealmId, permission.ResourceServerId, id)
+		if err != nil {
+			return "", err
+		}
+
+		if policy.Type == "client" && isOpenidClientTokenExchangeClientPolicyName(clientId, policy.Name) {
+			return id, nil
+		}
+	}
+
+	return "", nil
+}
+
+func resourceKeycloakOpenidClientTokenExchangePermissionUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	clientId := data.Get("client_id").(string)
+	clients := interfaceSliceToStringSlice(data.Get("clients").(*schema.Set).List())
+
+	err := keycloakClient.EnableOpenidClientPermissions(ctx, realmId, clientId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	permission, err := getOpenidClientTokenExchangePermission(ctx, keycloakClient, realmId, clientId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	policyId, err := findOpenidClientTokenExchangeClientPolicy(ctx, keycloakClient, permission, clientId, data.Get("policy_id").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if policyId == "" {
+		if len(permission.Policies) != 0 {
+			return diag.Errorf("the token-exchange permission of client %s already has policies that weren't created by this resource", clientId)
+		}
+
+		policyId, err = createOpenidClientTokenExchangeClientPolicy(ctx, keycloakClient, realmId, permission.ResourceServerId, clientId, clients)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		permission.Policies = []string{policyId}
+
+		err = keycloakClient.UpdateOpenidClientAuthorizationPermission(ctx, permission)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	} else {
+		policy, err := keycloakClient.GetOpenidClientAuthorizationClientPolicy(ctx, realmId, permission.ResourceServerId, policyId)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		policy.Clients = clients
+
+		err = keycloakClient.UpdateOpenidClientAuthorizationClientPolicy(ctx, policy)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	data.SetId(fmt.Sprintf("%s/%s", realmId, clientId))
+
+	return resourceKeycloakOpenidClientTokenExchangePermissionRead(ctx, data, meta)
+}
+
+func resourceKeycloakOpenidClientTokenExchangePermissionRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	clientId := data.Get("client_id").(string)
+
+	clientPermissions, err := keycloakClient.GetOpenidClientPermissions(ctx, realmId, clientId)
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	if !clientPermissions.Enabled {
+		tflog.Warn(ctx, "Removing resource from state as it is no longer enabled", map[string]interface{}{
+			"id": data.Id(),
+		})
+		data.SetId("")
+		return nil
+	}
+
+	permission, err := getOpenidClientTokenExchangePermission(ctx, keycloakClient, realmId, clientId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	policyId, err := findOpenidClientTokenExchangeClientPolicy(ctx, keycloakClient, permission, clientId, data.Get("policy_id").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// the policy was removed outside of terraform, so the clients need to be granted again
+	if policyId == "" {
+		data.Set("policy_id", "")
+		data.Set("clients", []string{})
+		return nil
+	}
+
+	policy, err := keycloakClient.GetOpenidClientAuthorizationClientPolicy(ctx, realmId, permission.ResourceServerId, policyId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.Set("policy_id", policy.Id)
+	data.Set("clients", policy.Clients)
+	data.Set("authorization_resource_server_id", permission.ResourceServerId)
+	data.Set("authorization_token_exchange_scope_permission_id", permission.Id)
+
+	return nil
+}
+
+func resourceKeycloakOpenidClientTokenExchangePermissionDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	clientId := data.Get("client_id").(string)
+	policyId := data.Get("policy_id").(string)
+
+	permission, err := getOpenidClientTokenExchangePermission(ctx, keycloakClient, realmId, clientId)
+	if err != nil {
+		if keycloak.ErrorIs404(err) {
+			return nil
+		}
+
+		return diag.FromErr(err)
+	}
+
+	if policyId == "" {
+		return nil
+	}
+
+	// permissions stay enabled, since the other permissions of the client may be managed by keycloak_openid_client_permissions
+	var policies []string
+	for _, id := range permission.Policies {
+		if id != policyId {
+			policies = append(policies, id)
+		}
+	}
+
+	if len(policies) != len(permission.Policies) {
+		permission.Policies = policies
+
+		err = keycloakClient.UpdateOpenidClientAuthorizationPermission(ctx, permission)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	err = keycloakClient.DeleteOpenidClientAuthorizationClientPolicy(ctx, realmId, permission.ResourceServerId, policyId)
+	if err != nil && !keycloak.ErrorIs404(err) {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceKeycloakOpenidClientTokenExchangePermissionImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("Invalid import. Supported import formats: {{realmId}}/{{clientId}}")
+	}
+
+	d.Set("realm_id", parts[0])
+	d.Set("client_id", parts[1])
+
+	diagnostics := resourceKeycloakOpenidClientTokenExchangePermissionRead(ctx, d, meta)
+	if diagnostics.HasError() {
+		return nil, errors.New(diagnostics[0].Summary)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
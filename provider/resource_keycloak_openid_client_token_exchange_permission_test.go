@@ -0,0 +1,209 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccKeycloakOpenidClientTokenExchangePermission_basic(t *testing.T) {
+	t.Parallel()
+
+	audienceClientId := acctest.RandomWithPrefix("tf-acc")
+	requestingClientId := acctest.RandomWithPrefix("tf-acc")
+	otherRequestingClientId := acctest.RandomWithPrefix("tf-acc")
+
+	resourceName := "keycloak_openid_client_token_exchange_permission.permission"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakOpenidClientTokenExchangePermissionDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOpenidClientTokenExchangePermission(audienceClientId, requestingClientId, otherRequestingClientId, "keycloak_openid_client.requesting.id"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakOpenidClientTokenExchangePermissionHasClients(resourceName, 1),
+					resource.TestCheckResourceAttr(resourceName, "clients.#", "1"),
+				),
+			},
+			{
+				Config: testKeycloakOpenidClientTokenExchangePermission(audienceClientId, requestingClientId, otherRequestingClientId, "keycloak_openid_client.requesting.id, keycloak_openid_client.other_requesting.id"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakOpenidClientTokenExchangePermissionHasClients(resourceName, 2),
+					resource.TestCheckResourceAttr(resourceName, "clients.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccKeycloakOpenidClientTokenExchangePermission_keepsPermissionsEnabled(t *testing.T) {
+	t.Parallel()
+
+	audienceClientId := acctest.RandomWithPrefix("tf-acc")
+	requestingClientId := acctest.RandomWithPrefix("tf-acc")
+	otherRequestingClientId := acctest.RandomWithPrefix("tf-acc")
+
+	resourceName := "keycloak_openid_client_token_exchange_permission.permission"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakOpenidClientTokenExchangePermissionDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOpenidClientTokenExchangePermission(audienceClientId, requestingClientId, otherRequestingClientId, "keycloak_openid_client.requesting.id"),
+				Check:  testAccCheckKeycloakOpenidClientTokenExchangePermissionHasClients(resourceName, 1),
+			},
+			{
+				// the other permissions of the audience client may be managed by keycloak_openid_client_permissions
+				Config: testKeycloakOpenidClientTokenExchangePermission_withoutPermission(audienceClientId, requestingClientId, otherRequestingClientId),
+				Check:  testAccCheckKeycloakOpenidClientPermissionsStillEnabled("keycloak_openid_client.audience"),
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakOpenidClientPermissionsStillEnabled(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		clientPermissions, err := keycloakClient.GetOpenidClientPermissions(testCtx, rs.Primary.Attributes["realm_id"], rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if !clientPermissions.Enabled {
+			return fmt.Errorf("expected permissions of client %s to still be enabled", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKeycloakOpenidClientTokenExchangePermissionHasClients(resourceName string, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		realmId := rs.Primary.Attributes["realm_id"]
+		clientId := rs.Primary.Attributes["client_id"]
+
+		permission, err := getOpenidClientTokenExchangePermission(testCtx, keycloakClient, realmId, clientId)
+		if err != nil {
+			return err
+		}
+
+		if len(permission.Policies) != 1 {
+			return fmt.Errorf("expected the token exchange permission of client %s to have one policy, got %d", clientId, len(permission.Policies))
+		}
+
+		policy, err := keycloakClient.GetOpenidClientAuthorizationClientPolicy(testCtx, realmId, permission.ResourceServerId, permission.Policies[0])
+		if err != nil {
+			return err
+		}
+
+		if len(policy.Clients) != count {
+			return fmt.Errorf("expected the token exchange policy of client %s to have %d clients, got %d", clientId, count, len(policy.Clients))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKeycloakOpenidClientTokenExchangePermissionDestroy() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "keycloak_openid_client_token_exchange_permission" {
+				continue
+			}
+
+			realmId := rs.Primary.Attributes["realm_id"]
+			resourceServerId := rs.Primary.Attributes["authorization_resource_server_id"]
+			policyId := rs.Primary.Attributes["policy_id"]
+
+			policy, _ := keycloakClient.GetOpenidClientAuthorizationClientPolicy(testCtx, realmId, resourceServerId, policyId)
+			if policy != nil {
+				return fmt.Errorf("token exchange client policy %s still exists", policyId)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakOpenidClientTokenExchangePermission(audienceClientId, requestingClientId, otherRequestingClientId, clients string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client" "audience" {
+	client_id   = "%s"
+	realm_id    = data.keycloak_realm.realm.id
+	access_type = "BEARER-ONLY"
+}
+
+resource "keycloak_openid_client" "requesting" {
+	client_id                = "%s"
+	realm_id                 = data.keycloak_realm.realm.id
+	access_type              = "CONFIDENTIAL"
+	service_accounts_enabled = true
+}
+
+resource "keycloak_openid_client" "other_requesting" {
+	client_id                = "%s"
+	realm_id                 = data.keycloak_realm.realm.id
+	access_type              = "CONFIDENTIAL"
+	service_accounts_enabled = true
+}
+
+resource "keycloak_openid_client_token_exchange_permission" "permission" {
+	realm_id  = data.keycloak_realm.realm.id
+	client_id = keycloak_openid_client.audience.id
+	clients   = [%s]
+}
+	`, testAccRealm.Realm, audienceClientId, requestingClientId, otherRequestingClientId, clients)
+}
+
+func testKeycloakOpenidClientTokenExchangePermission_withoutPermission(audienceClientId, requestingClientId, otherRequestingClientId string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client" "audience" {
+	client_id   = "%s"
+	realm_id    = data.keycloak_realm.realm.id
+	access_type = "BEARER-ONLY"
+}
+
+resource "keycloak_openid_client" "requesting" {
+	client_id                = "%s"
+	realm_id                 = data.keycloak_realm.realm.id
+	access_type              = "CONFIDENTIAL"
+	service_accounts_enabled = true
+}
+
+resource "keycloak_openid_client" "other_requesting" {
+	client_id                = "%s"
+	realm_id                 = data.keycloak_realm.realm.id
+	access_type              = "CONFIDENTIAL"
+	service_accounts_enabled = true
+}
+	`, testAccRealm.Realm, audienceClientId, requestingClientId, otherRequestingClientId)
+}
@@ -57,6 +57,18 @@ func resourceKeycloakOpenIdFullNameProtocolMapper() *schema.Resource {
 				Optional: true,
 				Default:  true,
 			},
+			"add_to_token_introspection": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Indicates if the attribute should be a claim in the token introspection response. Defaults to the value of add_to_access_token.",
+			},
+			"add_to_lightweight_claim": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Indicates if the attribute should be a claim in lightweight access tokens.",
+			},
 			"add_to_userinfo": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -74,9 +86,11 @@ func mapFromDataToOpenIdFullNameProtocolMapper(data *schema.ResourceData) *keycl
 		ClientId:      data.Get("client_id").(string),
 		ClientScopeId: data.Get("client_scope_id").(string),
 
-		AddToIdToken:     data.Get("add_to_id_token").(bool),
-		AddToAccessToken: data.Get("add_to_access_token").(bool),
-		AddToUserInfo:    data.Get("add_to_userinfo").(bool),
+		AddToIdToken:            data.Get("add_to_id_token").(bool),
+		AddToAccessToken:        data.Get("add_to_access_token").(bool),
+		AddToTokenIntrospection: getAddToTokenIntrospectionFromData(data),
+		AddToLightweightClaim:   data.Get("add_to_lightweight_claim").(bool),
+		AddToUserInfo:           data.Get("add_to_userinfo").(bool),
 	}
 }
 
@@ -93,6 +107,8 @@ func mapFromOpenIdFullNameMapperToData(mapper *keycloak.OpenIdFullNameProtocolMa
 
 	data.Set("add_to_id_token", mapper.AddToIdToken)
 	data.Set("add_to_access_token", mapper.AddToAccessToken)
+	data.Set("add_to_token_introspection", mapper.AddToTokenIntrospection)
+	data.Set("add_to_lightweight_claim", mapper.AddToLightweightClaim)
 	data.Set("add_to_userinfo", mapper.AddToUserInfo)
 }
 
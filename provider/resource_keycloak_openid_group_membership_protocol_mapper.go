@@ -66,6 +66,18 @@ func resourceKeycloakOpenIdGroupMembershipProtocolMapper() *schema.Resource {
 				Optional: true,
 				Default:  true,
 			},
+			"add_to_token_introspection": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Indicates if the attribute should be a claim in the token introspection response. Defaults to the value of add_to_access_token.",
+			},
+			"add_to_lightweight_claim": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Indicates if the attribute should be a claim in lightweight access tokens.",
+			},
 			"add_to_userinfo": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -83,11 +95,13 @@ func mapFromDataToOpenIdGroupMembershipProtocolMapper(data *schema.ResourceData)
 		ClientId:      data.Get("client_id").(string),
 		ClientScopeId: data.Get("client_scope_id").(string),
 
-		ClaimName:        data.Get("claim_name").(string),
-		FullPath:         data.Get("full_path").(bool),
-		AddToIdToken:     data.Get("add_to_id_token").(bool),
-		AddToAccessToken: data.Get("add_to_access_token").(bool),
-		AddToUserinfo:    data.Get("add_to_userinfo").(bool),
+		ClaimName:               data.Get("claim_name").(string),
+		FullPath:                data.Get("full_path").(bool),
+		AddToIdToken:            data.Get("add_to_id_token").(bool),
+		AddToAccessToken:        data.Get("add_to_access_token").(bool),
+		AddToTokenIntrospection: getAddToTokenIntrospectionFromData(data),
+		AddToLightweightClaim:   data.Get("add_to_lightweight_claim").(bool),
+		AddToUserinfo:           data.Get("add_to_userinfo").(bool),
 	}
 }
 
@@ -106,6 +120,8 @@ func mapFromOpenIdGroupMembershipMapperToData(mapper *keycloak.OpenIdGroupMember
 	data.Set("full_path", mapper.FullPath)
 	data.Set("add_to_id_token", mapper.AddToIdToken)
 	data.Set("add_to_access_token", mapper.AddToAccessToken)
+	data.Set("add_to_token_introspection", mapper.AddToTokenIntrospection)
+	data.Set("add_to_lightweight_claim", mapper.AddToLightweightClaim)
 	data.Set("add_to_userinfo", mapper.AddToUserinfo)
 }
 
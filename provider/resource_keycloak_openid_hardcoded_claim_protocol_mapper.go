@@ -59,6 +59,18 @@ func resourceKeycloakOpenIdHardcodedClaimProtocolMapper() *schema.Resource {
 				Default:     true,
 				Description: "Indicates if the attribute should be a claim in the access token.",
 			},
+			"add_to_token_introspection": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Indicates if the attribute should be a claim in the token introspection response. Defaults to the value of add_to_access_token.",
+			},
+			"add_to_lightweight_claim": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Indicates if the attribute should be a claim in lightweight access tokens.",
+			},
 			"add_to_userinfo": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -86,14 +98,16 @@ func resourceKeycloakOpenIdHardcodedClaimProtocolMapper() *schema.Resource {
 
 func mapFromDataToOpenIdHardcodedClaimProtocolMapper(data *schema.ResourceData) *keycloak.OpenIdHardcodedClaimProtocolMapper {
 	return &keycloak.OpenIdHardcodedClaimProtocolMapper{
-		Id:               data.Id(),
-		Name:             data.Get("name").(string),
-		RealmId:          data.Get("realm_id").(string),
-		ClientId:         data.Get("client_id").(string),
-		ClientScopeId:    data.Get("client_scope_id").(string),
-		AddToIdToken:     data.Get("add_to_id_token").(bool),
-		AddToAccessToken: data.Get("add_to_access_token").(bool),
-		AddToUserInfo:    data.Get("add_to_userinfo").(bool),
+		Id:                      data.Id(),
+		Name:                    data.Get("name").(string),
+		RealmId:                 data.Get("realm_id").(string),
+		ClientId:                data.Get("client_id").(string),
+		ClientScopeId:           data.Get("client_scope_id").(string),
+		AddToIdToken:            data.Get("add_to_id_token").(bool),
+		AddToAccessToken:        data.Get("add_to_access_token").(bool),
+		AddToTokenIntrospection: getAddToTokenIntrospectionFromData(data),
+		AddToLightweightClaim:   data.Get("add_to_lightweight_claim").(bool),
+		AddToUserInfo:           data.Get("add_to_userinfo").(bool),
 
 		ClaimName:      data.Get("claim_name").(string),
 		ClaimValue:     data.Get("claim_value").(string),
@@ -114,6 +128,8 @@ func mapFromOpenIdHardcodedClaimMapperToData(mapper *keycloak.OpenIdHardcodedCla
 
 	data.Set("add_to_id_token", mapper.AddToIdToken)
 	data.Set("add_to_access_token", mapper.AddToAccessToken)
+	data.Set("add_to_token_introspection", mapper.AddToTokenIntrospection)
+	data.Set("add_to_lightweight_claim", mapper.AddToLightweightClaim)
 	data.Set("add_to_userinfo", mapper.AddToUserInfo)
 	data.Set("claim_name", mapper.ClaimName)
 	data.Set("claim_value", mapper.ClaimValue)
@@ -0,0 +1,155 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/charlesderek/terraform-w-keycloak/keycloak"
+)
+
+func resourceKeycloakOpenIdPairwiseSubjectProtocolMapper() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKeycloakOpenIdPairwiseSubjectProtocolMapperCreate,
+		ReadContext:   resourceKeycloakOpenIdPairwiseSubjectProtocolMapperRead,
+		UpdateContext: resourceKeycloakOpenIdPairwiseSubjectProtocolMapperUpdate,
+		DeleteContext: resourceKeycloakOpenIdPairwiseSubjectProtocolMapperDelete,
+		Importer: &schema.ResourceImporter{
+			// {{realmId}}/client/{{clientId}}/{{protocolMapperId}}
+			StateContext: resourceKeycloakOpenIdPairwiseSubjectProtocolMapperImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "A human-friendly name that will appear in the Keycloak console.",
+			},
+			"realm_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The realm id where the associated client exists.",
+			},
+			"client_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The mapper's associated client. Pairwise subject identifiers cannot be configured on client scopes.",
+			},
+			"sector_identifier_uri": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsURLWithHTTPS,
+				Description:  "A https url of a json document listing the redirect uris of the client. Required when the redirect uris of the client have multiple hosts.",
+			},
+			"pairwise_sub_algorithm_salt": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The salt used when calculating the pairwise subject identifier. Keycloak generates one when it is omitted.",
+			},
+		},
+	}
+}
+
+func mapFromDataToOpenIdPairwiseSubjectProtocolMapper(data *schema.ResourceData) *keycloak.OpenIdPairwiseSubjectProtocolMapper {
+	return &keycloak.OpenIdPairwiseSubjectProtocolMapper{
+		Id:       data.Id(),
+		Name:     data.Get("name").(string),
+		RealmId:  data.Get("realm_id").(string),
+		ClientId: data.Get("client_id").(string),
+
+		SectorIdentifierUri:      data.Get("sector_identifier_uri").(string),
+		PairwiseSubAlgorithmSalt: data.Get("pairwise_sub_algorithm_salt").(string),
+	}
+}
+
+func mapFromOpenIdPairwiseSubjectMapperToData(mapper *keycloak.OpenIdPairwiseSubjectProtocolMapper, data *schema.ResourceData) {
+	data.SetId(mapper.Id)
+	data.Set("name", mapper.Name)
+	data.Set("realm_id", mapper.RealmId)
+	data.Set("client_id", mapper.ClientId)
+	data.Set("sector_identifier_uri", mapper.SectorIdentifierUri)
+	data.Set("pairwise_sub_algorithm_salt", mapper.PairwiseSubAlgorithmSalt)
+}
+
+func resourceKeycloakOpenIdPairwiseSubjectProtocolMapperCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	openIdPairwiseSubjectMapper := mapFromDataToOpenIdPairwiseSubjectProtocolMapper(data)
+
+	err := keycloakClient.ValidateOpenIdPairwiseSubjectProtocolMapper(ctx, openIdPairwiseSubjectMapper)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = keycloakClient.NewOpenIdPairwiseSubjectProtocolMapper(ctx, openIdPairwiseSubjectMapper)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	mapFromOpenIdPairwiseSubjectMapperToData(openIdPairwiseSubjectMapper, data)
+
+	return resourceKeycloakOpenIdPairwiseSubjectProtocolMapperRead(ctx, data, meta)
+}
+
+func resourceKeycloakOpenIdPairwiseSubjectProtocolMapperRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	clientId := data.Get("client_id").(string)
+
+	openIdPairwiseSubjectMapper, err := keycloakClient.GetOpenIdPairwiseSubjectProtocolMapper(ctx, realmId, clientId, data.Id())
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	mapFromOpenIdPairwiseSubjectMapperToData(openIdPairwiseSubjectMapper, data)
+
+	return nil
+}
+
+func resourceKeycloakOpenIdPairwiseSubjectProtocolMapperUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	openIdPairwiseSubjectMapper := mapFromDataToOpenIdPairwiseSubjectProtocolMapper(data)
+
+	err := keycloakClient.ValidateOpenIdPairwiseSubjectProtocolMapper(ctx, openIdPairwiseSubjectMapper)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = keycloakClient.UpdateOpenIdPairwiseSubjectProtocolMapper(ctx, openIdPairwiseSubjectMapper)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceKeycloakOpenIdPairwiseSubjectProtocolMapperRead(ctx, data, meta)
+}
+
+func resourceKeycloakOpenIdPairwiseSubjectProtocolMapperDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	clientId := data.Get("client_id").(string)
+
+	return diag.FromErr(keycloakClient.DeleteOpenIdPairwiseSubjectProtocolMapper(ctx, realmId, clientId, data.Id()))
+}
+
+func resourceKeycloakOpenIdPairwiseSubjectProtocolMapperImport(_ context.Context, data *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(data.Id(), "/")
+	if len(parts) != 4 || parts[1] != "client" {
+		return nil, fmt.Errorf("invalid import. supported import formats: {{realmId}}/client/{{clientId}}/{{protocolMapperId}}")
+	}
+
+	data.Set("realm_id", parts[0])
+	data.Set("client_id", parts[2])
+	data.SetId(parts[3])
+
+	return []*schema.ResourceData{data}, nil
+}
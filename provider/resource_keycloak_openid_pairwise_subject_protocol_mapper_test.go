@@ -0,0 +1,119 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/charlesderek/terraform-w-keycloak/keycloak"
+)
+
+func TestAccKeycloakOpenIdPairwiseSubjectProtocolMapper_basic(t *testing.T) {
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+
+	resourceName := "keycloak_openid_pairwise_subject_protocol_mapper.pairwise_subject_mapper"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccKeycloakOpenIdPairwiseSubjectProtocolMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOpenIdPairwiseSubjectProtocolMapper_basic(clientId, mapperName, "https://app.example.com/callback"),
+				Check: resource.ComposeTestCheckFunc(
+					testKeycloakOpenIdPairwiseSubjectProtocolMapperExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "pairwise_sub_algorithm_salt"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: getGenericProtocolMapperIdForClient(resourceName),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakOpenIdPairwiseSubjectProtocolMapper_multipleHostsRequireSectorIdentifier(t *testing.T) {
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccKeycloakOpenIdPairwiseSubjectProtocolMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakOpenIdPairwiseSubjectProtocolMapper_basic(clientId, mapperName, "https://app.example.com/callback\", \"https://other.example.com/callback"),
+				ExpectError: regexp.MustCompile("validation error: the redirect uris of client .+ have multiple hosts, which requires a sector identifier uri"),
+			},
+		},
+	})
+}
+
+func testAccKeycloakOpenIdPairwiseSubjectProtocolMapperDestroy() resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		for resourceName, rs := range state.RootModule().Resources {
+			if rs.Type != "keycloak_openid_pairwise_subject_protocol_mapper" {
+				continue
+			}
+
+			mapper, _ := getPairwiseSubjectMapperUsingState(state, resourceName)
+
+			if mapper != nil {
+				return fmt.Errorf("openid pairwise subject protocol mapper with id %s still exists", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakOpenIdPairwiseSubjectProtocolMapperExists(resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		_, err := getPairwiseSubjectMapperUsingState(state, resourceName)
+
+		return err
+	}
+}
+
+func getPairwiseSubjectMapperUsingState(state *terraform.State, resourceName string) (*keycloak.OpenIdPairwiseSubjectProtocolMapper, error) {
+	rs, ok := state.RootModule().Resources[resourceName]
+	if !ok {
+		return nil, fmt.Errorf("resource not found in TF state: %s ", resourceName)
+	}
+
+	id := rs.Primary.ID
+	realm := rs.Primary.Attributes["realm_id"]
+	clientId := rs.Primary.Attributes["client_id"]
+
+	return keycloakClient.GetOpenIdPairwiseSubjectProtocolMapper(testCtx, realm, clientId, id)
+}
+
+func testKeycloakOpenIdPairwiseSubjectProtocolMapper_basic(clientId, mapperName, redirectUris string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client" "openid_client" {
+	realm_id              = data.keycloak_realm.realm.id
+	client_id             = "%s"
+	access_type           = "CONFIDENTIAL"
+	standard_flow_enabled = true
+	valid_redirect_uris   = ["%s"]
+}
+
+resource "keycloak_openid_pairwise_subject_protocol_mapper" "pairwise_subject_mapper" {
+	name      = "%s"
+	realm_id  = data.keycloak_realm.realm.id
+	client_id = keycloak_openid_client.openid_client.id
+}`, testAccRealm.Realm, clientId, redirectUris, mapperName)
+}
@@ -145,6 +145,55 @@ func TestAccKeycloakOpenIdUserAttributeProtocolMapper_createAfterManualDestroy(t
 	})
 }
 
+func TestAccKeycloakOpenIdUserAttributeProtocolMapper_withoutTokenIntrospectionSetting(t *testing.T) {
+	t.Parallel()
+	var mapper = &keycloak.OpenIdUserAttributeProtocolMapper{}
+
+	clientId := acctest.RandomWithPrefix("tf-acc")
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+
+	resourceName := "keycloak_openid_user_attribute_protocol_mapper.user_attribute_mapper_client"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccKeycloakOpenIdUserAttributeProtocolMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOpenIdUserAttributeProtocolMapper_withoutAccessToken(clientId, mapperName),
+				Check: resource.ComposeTestCheckFunc(
+					testKeycloakOpenIdUserAttributeProtocolMapperFetch(resourceName, mapper),
+					resource.TestCheckResourceAttr(resourceName, "add_to_token_introspection", "false"),
+				),
+			},
+			{
+				// mappers created before keycloak 25 don't have the introspection setting at all
+				PreConfig: func() {
+					genericMapper, err := keycloakClient.GetGenericProtocolMapper(testCtx, mapper.RealmId, mapper.ClientId, mapper.ClientScopeId, mapper.Id)
+					if err != nil {
+						t.Fatal(err)
+					}
+
+					delete(genericMapper.Config, "introspection.token.claim")
+
+					err = keycloakClient.UpdateGenericProtocolMapper(testCtx, genericMapper)
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: getGenericProtocolMapperIdForClient(resourceName),
+			},
+			{
+				Config:   testKeycloakOpenIdUserAttributeProtocolMapper_withoutAccessToken(clientId, mapperName),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func TestAccKeycloakOpenIdUserAttributeProtocolMapper_validateClaimValueType(t *testing.T) {
 	t.Parallel()
 	mapperName := acctest.RandomWithPrefix("tf-acc")
@@ -294,6 +343,29 @@ resource "keycloak_openid_user_attribute_protocol_mapper" "user_attribute_mapper
 }`, testAccRealm.Realm, clientId, mapperName)
 }
 
+func testKeycloakOpenIdUserAttributeProtocolMapper_withoutAccessToken(clientId, mapperName string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client" "openid_client" {
+	realm_id  = data.keycloak_realm.realm.id
+	client_id = "%s"
+
+	access_type = "BEARER-ONLY"
+}
+
+resource "keycloak_openid_user_attribute_protocol_mapper" "user_attribute_mapper_client" {
+	name                = "%s"
+	realm_id            = data.keycloak_realm.realm.id
+	client_id           = keycloak_openid_client.openid_client.id
+	user_attribute      = "foo"
+	claim_name          = "bar"
+	add_to_access_token = false
+}`, testAccRealm.Realm, clientId, mapperName)
+}
+
 func testKeycloakOpenIdUserAttributeProtocolMapper_basic_clientScope(clientScopeId, mapperName string) string {
 	return fmt.Sprintf(`
 data "keycloak_realm" "realm" {
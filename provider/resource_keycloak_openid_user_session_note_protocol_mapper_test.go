@@ -142,6 +142,38 @@ func TestAccKeycloakOpenIdUserSessionNoteProtocolMapper_updateNote(t *testing.T)
 	})
 }
 
+func TestAccKeycloakOpenIdUserSessionNoteProtocolMapper_updateTokenClaims(t *testing.T) {
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+
+	resourceName := "keycloak_openid_user_session_note_protocol_mapper.user_session_note_mapper"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccKeycloakOpenIdUserSessionNoteProtocolMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOpenIdUserSessionNoteProtocolMapper_tokenClaims(clientId, mapperName, true, false),
+				Check: resource.ComposeTestCheckFunc(
+					testKeycloakOpenIdUserSessionNoteProtocolMapperExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "add_to_token_introspection", "true"),
+					resource.TestCheckResourceAttr(resourceName, "add_to_lightweight_claim", "false"),
+				),
+			},
+			{
+				Config: testKeycloakOpenIdUserSessionNoteProtocolMapper_tokenClaims(clientId, mapperName, false, true),
+				Check: resource.ComposeTestCheckFunc(
+					testKeycloakOpenIdUserSessionNoteProtocolMapperExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "add_to_token_introspection", "false"),
+					resource.TestCheckResourceAttr(resourceName, "add_to_lightweight_claim", "true"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccKeycloakOpenIdUserSessionNoteProtocolMapper_createAfterManualDestroy(t *testing.T) {
 	t.Parallel()
 	var mapper = &keycloak.OpenIdUserSessionNoteProtocolMapper{}
@@ -404,6 +436,27 @@ resource "keycloak_openid_user_session_note_protocol_mapper" "user_session_note_
 }`, testAccRealm.Realm, clientId, mapperName, noteName)
 }
 
+func testKeycloakOpenIdUserSessionNoteProtocolMapper_tokenClaims(clientId, mapperName string, addToTokenIntrospection, addToLightweightClaim bool) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+resource "keycloak_openid_client" "openid_client" {
+	realm_id  = data.keycloak_realm.realm.id
+	client_id = "%s"
+	access_type = "BEARER-ONLY"
+}
+resource "keycloak_openid_user_session_note_protocol_mapper" "user_session_note_mapper" {
+	name                       = "%s"
+	realm_id                   = data.keycloak_realm.realm.id
+	client_id                  = "${keycloak_openid_client.openid_client.id}"
+	claim_name                 = "foo"
+	claim_value_type           = "String"
+	add_to_token_introspection = %t
+	add_to_lightweight_claim   = %t
+}`, testAccRealm.Realm, clientId, mapperName, addToTokenIntrospection, addToLightweightClaim)
+}
+
 func testKeycloakOpenIdUserSessionNoteProtocolMapper_import(clientId, clientScopeId, mapperName string) string {
 	return fmt.Sprintf(`
 data "keycloak_realm" "realm" {
@@ -0,0 +1,26 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/charlesderek/terraform-w-keycloak/keycloak"
+)
+
+func resourceKeycloakOpenshiftIdentityProvider() *schema.Resource {
+	openshiftSchema := map[string]*schema.Schema{
+		"base_url": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The base URL of the OpenShift v4 cluster's API server.",
+		},
+	}
+
+	return resourceKeycloakSocialIdentityProvider("openshift-v4", openshiftSchema, getOpenshiftIdentityProviderConfigFromData, setOpenshiftIdentityProviderConfigData)
+}
+
+func getOpenshiftIdentityProviderConfigFromData(data *schema.ResourceData, config *keycloak.IdentityProviderConfig) {
+	setSocialIdentityProviderConfigValue(config, "baseUrl", data.Get("base_url").(string))
+}
+
+func setOpenshiftIdentityProviderConfigData(data *schema.ResourceData, config *keycloak.IdentityProviderConfig) {
+	data.Set("base_url", getSocialIdentityProviderConfigValue(config, "baseUrl"))
+}
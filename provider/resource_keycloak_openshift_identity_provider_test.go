@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/charlesderek/terraform-w-keycloak/keycloak"
+	"testing"
+)
+
+func TestAccKeycloakOpenshiftIdentityProvider_basic(t *testing.T) {
+	t.Parallel()
+
+	alias := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakSocialIdentityProviderDestroy("keycloak_openshift_identity_provider"),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOpenshiftIdentityProvider_basic(alias),
+				Check:  testAccCheckKeycloakSocialIdentityProviderExists("keycloak_openshift_identity_provider.openshift", "openshift-v4"),
+			},
+			{
+				ResourceName:            "keycloak_openshift_identity_provider.openshift",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdPrefix:     testAccRealm.Realm + "/",
+				ImportStateVerifyIgnore: []string{"client_secret"},
+			},
+		},
+	})
+}
+
+func TestAccKeycloakOpenshiftIdentityProvider_createAfterManualDestroy(t *testing.T) {
+	t.Parallel()
+
+	var idp = &keycloak.IdentityProvider{}
+	alias := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakSocialIdentityProviderDestroy("keycloak_openshift_identity_provider"),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOpenshiftIdentityProvider_basic(alias),
+				Check:  testAccCheckKeycloakSocialIdentityProviderFetch("keycloak_openshift_identity_provider.openshift", idp),
+			},
+			{
+				PreConfig: func() {
+					err := keycloakClient.DeleteIdentityProvider(testCtx, idp.Realm, idp.Alias)
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testKeycloakOpenshiftIdentityProvider_basic(alias),
+				Check:  testAccCheckKeycloakSocialIdentityProviderExists("keycloak_openshift_identity_provider.openshift", "openshift-v4"),
+			},
+		},
+	})
+}
+
+func testKeycloakOpenshiftIdentityProvider_basic(alias string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openshift_identity_provider" "openshift" {
+	realm         = data.keycloak_realm.realm.id
+	alias         = "%s"
+	client_id     = "example_id"
+	client_secret = "example_token"
+	base_url      = "https://api.openshift.example.com:6443"
+}
+	`, testAccRealm.Realm, alias)
+}
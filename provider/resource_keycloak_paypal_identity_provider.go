@@ -0,0 +1,29 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/charlesderek/terraform-w-keycloak/keycloak"
+)
+
+func resourceKeycloakPaypalIdentityProvider() *schema.Resource {
+	paypalSchema := map[string]*schema.Schema{
+		"sandbox": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Target the PayPal sandbox environment instead of production.",
+		},
+	}
+
+	return resourceKeycloakSocialIdentityProvider("paypal", paypalSchema, getPaypalIdentityProviderConfigFromData, setPaypalIdentityProviderConfigData)
+}
+
+func getPaypalIdentityProviderConfigFromData(data *schema.ResourceData, config *keycloak.IdentityProviderConfig) {
+	if data.Get("sandbox").(bool) {
+		setSocialIdentityProviderConfigValue(config, "sandbox", "true")
+	}
+}
+
+func setPaypalIdentityProviderConfigData(data *schema.ResourceData, config *keycloak.IdentityProviderConfig) {
+	data.Set("sandbox", getSocialIdentityProviderConfigValue(config, "sandbox") == "true")
+}
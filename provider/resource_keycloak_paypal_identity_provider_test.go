@@ -0,0 +1,123 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/charlesderek/terraform-w-keycloak/keycloak"
+	"testing"
+)
+
+func TestAccKeycloakPaypalIdentityProvider_basic(t *testing.T) {
+	t.Parallel()
+
+	alias := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakSocialIdentityProviderDestroy("keycloak_paypal_identity_provider"),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakPaypalIdentityProvider_basic(alias),
+				Check:  testAccCheckKeycloakSocialIdentityProviderExists("keycloak_paypal_identity_provider.paypal", "paypal"),
+			},
+			{
+				ResourceName:            "keycloak_paypal_identity_provider.paypal",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdPrefix:     testAccRealm.Realm + "/",
+				ImportStateVerifyIgnore: []string{"client_secret"},
+			},
+		},
+	})
+}
+
+func TestAccKeycloakPaypalIdentityProvider_createAfterManualDestroy(t *testing.T) {
+	t.Parallel()
+
+	var idp = &keycloak.IdentityProvider{}
+	alias := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakSocialIdentityProviderDestroy("keycloak_paypal_identity_provider"),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakPaypalIdentityProvider_basic(alias),
+				Check:  testAccCheckKeycloakSocialIdentityProviderFetch("keycloak_paypal_identity_provider.paypal", idp),
+			},
+			{
+				PreConfig: func() {
+					err := keycloakClient.DeleteIdentityProvider(testCtx, idp.Realm, idp.Alias)
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testKeycloakPaypalIdentityProvider_basic(alias),
+				Check:  testAccCheckKeycloakSocialIdentityProviderExists("keycloak_paypal_identity_provider.paypal", "paypal"),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakPaypalIdentityProvider_providerConfig(t *testing.T) {
+	t.Parallel()
+
+	var idp = &keycloak.IdentityProvider{}
+	alias := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakSocialIdentityProviderDestroy("keycloak_paypal_identity_provider"),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakPaypalIdentityProvider_providerConfig(alias),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakSocialIdentityProviderFetch("keycloak_paypal_identity_provider.paypal", idp),
+					func(_ *terraform.State) error {
+						if idp.Config.ExtraConfig["sandbox"] != "true" {
+							return fmt.Errorf("expected sandbox to be true")
+						}
+
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func testKeycloakPaypalIdentityProvider_basic(alias string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_paypal_identity_provider" "paypal" {
+	realm         = data.keycloak_realm.realm.id
+	alias         = "%s"
+	client_id     = "example_id"
+	client_secret = "example_token"
+}
+	`, testAccRealm.Realm, alias)
+}
+
+func testKeycloakPaypalIdentityProvider_providerConfig(alias string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_paypal_identity_provider" "paypal" {
+	realm         = data.keycloak_realm.realm.id
+	alias         = "%s"
+	client_id     = "example_id"
+	client_secret = "example_token"
+	sandbox       = true
+}
+	`, testAccRealm.Realm, alias)
+}
@@ -0,0 +1,116 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/charlesderek/terraform-w-keycloak/keycloak"
+)
+
+func resourceKeycloakRealmDefaultClientScopes() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKeycloakRealmDefaultClientScopesReconcile,
+		ReadContext:   resourceKeycloakRealmDefaultClientScopesRead,
+		DeleteContext: resourceKeycloakRealmDefaultClientScopesDelete,
+		UpdateContext: resourceKeycloakRealmDefaultClientScopesReconcile,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceKeycloakRealmDefaultClientScopesImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"default_scopes": {
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Required:    true,
+				Set:         schema.HashString,
+				Description: "The names of the openid-connect and saml client scopes that are attached to new clients as default scopes.",
+			},
+		},
+	}
+}
+
+func resourceKeycloakRealmDefaultClientScopesRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+
+	clientScopes, err := keycloakClient.GetRealmDefaultClientScopes(ctx, realmId)
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	var defaultScopes []string
+	for _, clientScope := range clientScopes {
+		defaultScopes = append(defaultScopes, clientScope.Name)
+	}
+
+	data.Set("default_scopes", defaultScopes)
+	data.SetId(realmId)
+
+	return nil
+}
+
+func resourceKeycloakRealmDefaultClientScopesReconcile(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	tfRealmDefaultClientScopes := data.Get("default_scopes").(*schema.Set)
+
+	keycloakRealmDefaultClientScopes, err := keycloakClient.GetRealmDefaultClientScopes(ctx, realmId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var realmDefaultClientScopesToDetach []string
+	for _, keycloakRealmDefaultClientScope := range keycloakRealmDefaultClientScopes {
+		// if this scope is attached in keycloak and tf state, no update is required
+		// remove it from the set so we can look at scopes that need to be attached later
+		if tfRealmDefaultClientScopes.Contains(keycloakRealmDefaultClientScope.Name) {
+			tfRealmDefaultClientScopes.Remove(keycloakRealmDefaultClientScope.Name)
+		} else {
+			// if this scope is attached in keycloak but not in tf state, add them to a slice containing all scopes to detach
+			realmDefaultClientScopesToDetach = append(realmDefaultClientScopesToDetach, keycloakRealmDefaultClientScope.Name)
+		}
+	}
+
+	// detach scopes that aren't in tf state
+	err = keycloakClient.DetachRealmDefaultClientScopes(ctx, realmId, realmDefaultClientScopesToDetach)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// attach scopes that exist in tf state but not in keycloak
+	err = keycloakClient.AttachRealmDefaultClientScopes(ctx, realmId, interfaceSliceToStringSlice(tfRealmDefaultClientScopes.List()))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(realmId)
+
+	return resourceKeycloakRealmDefaultClientScopesRead(ctx, data, meta)
+}
+
+func resourceKeycloakRealmDefaultClientScopesDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	defaultScopes := data.Get("default_scopes").(*schema.Set)
+
+	return diag.FromErr(keycloakClient.DetachRealmDefaultClientScopes(ctx, realmId, interfaceSliceToStringSlice(defaultScopes.List())))
+}
+
+func resourceKeycloakRealmDefaultClientScopesImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("realm_id", d.Id())
+
+	diagnostics := resourceKeycloakRealmDefaultClientScopesRead(ctx, d, meta)
+	if diagnostics.HasError() {
+		return nil, errors.New(diagnostics[0].Summary)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccKeycloakRealmDefaultClientScopes_basic(t *testing.T) {
+	t.Parallel()
+	realmName := acctest.RandomWithPrefix("tf-acc")
+	openidClientScope := acctest.RandomWithPrefix("tf-acc")
+	samlClientScope := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakRealmDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealmDefaultClientScopes(realmName, openidClientScope, samlClientScope, []string{openidClientScope, samlClientScope}),
+				Check:  testAccCheckKeycloakRealmHasDefaultClientScopes(realmName, []string{openidClientScope, samlClientScope}),
+			},
+			{
+				ResourceName:      "keycloak_realm_default_client_scopes.default_scopes",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testKeycloakRealmDefaultClientScopes(realmName, openidClientScope, samlClientScope, []string{samlClientScope}),
+				Check:  testAccCheckKeycloakRealmHasDefaultClientScopes(realmName, []string{samlClientScope}),
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakRealmHasDefaultClientScopes(realmName string, expectedScopes []string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		clientScopes, err := keycloakClient.GetRealmDefaultClientScopes(testCtx, realmName)
+		if err != nil {
+			return err
+		}
+
+		var scopes []string
+		for _, clientScope := range clientScopes {
+			scopes = append(scopes, clientScope.Name)
+		}
+
+		sort.Strings(scopes)
+		sort.Strings(expectedScopes)
+
+		if strings.Join(scopes, ",") != strings.Join(expectedScopes, ",") {
+			return fmt.Errorf("expected realm %s to have default client scopes %v, got %v", realmName, expectedScopes, scopes)
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakRealmDefaultClientScopes(realmName, openidClientScope, samlClientScope string, defaultScopes []string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client_scope" "openid_client_scope" {
+	realm_id = keycloak_realm.realm.id
+	name     = "%s"
+}
+
+resource "keycloak_saml_client_scope" "saml_client_scope" {
+	realm_id = keycloak_realm.realm.id
+	name     = "%s"
+}
+
+resource "keycloak_realm_default_client_scopes" "default_scopes" {
+	realm_id       = keycloak_realm.realm.id
+	default_scopes = %s
+
+	depends_on = [
+		keycloak_openid_client_scope.openid_client_scope,
+		keycloak_saml_client_scope.saml_client_scope,
+	]
+}
+	`, realmName, openidClientScope, samlClientScope, arrayOfStringsForTerraformResource(defaultScopes))
+}
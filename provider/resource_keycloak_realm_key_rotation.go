@@ -0,0 +1,668 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/charlesderek/terraform-w-keycloak/keycloak"
+)
+
+var keycloakRealmKeyRotationProviderIds = []string{"rsa-generated", "ecdsa-generated"}
+
+// the time format used for the names of the keystores that are created by a rotation
+const realmKeyRotationNameTimeFormat = "20060102150405"
+
+// realmKeyRotationKey is a single keystore managed by a key rotation. keycloak has no place to store the lifecycle
+// of a key, so the timestamps are only tracked in state.
+type realmKeyRotationKey struct {
+	Id         string
+	Name       string
+	Kid        string
+	Status     string
+	CreatedAt  time.Time
+	PromotedAt time.Time
+	RetiredAt  time.Time
+	DisabledAt time.Time
+}
+
+type realmKeyRotationSchedule struct {
+	RotationInterval time.Duration
+	PromoteAfter     time.Duration
+	GracePeriod      time.Duration
+}
+
+func resourceKeycloakRealmKeyRotation() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKeycloakRealmKeyRotationCreate,
+		ReadContext:   resourceKeycloakRealmKeyRotationRead,
+		UpdateContext: resourceKeycloakRealmKeyRotationUpdate,
+		DeleteContext: resourceKeycloakRealmKeyRotationDelete,
+		CustomizeDiff: resourceKeycloakRealmKeyRotationCustomizeDiff,
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name_prefix": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Prefix for the display names of the keystores created by this rotation.",
+			},
+			"provider_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "rsa-generated",
+				ValidateFunc: validation.StringInSlice(keycloakRealmKeyRotationProviderIds, false),
+				Description:  "The keystore provider used to generate the keys.",
+			},
+			"priority": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     0,
+				Description: "Priority for the generated keys.",
+			},
+			"algorithm": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(keycloakRealmKeystoreRsaGeneratedAlgorithm, false),
+				Default:      "RS256",
+				Description:  "Intended algorithm for the keys. Only used by rsa-generated keys.",
+			},
+			"key_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntInSlice(keycloakRealmKeystoreRsaGeneratedSize),
+				Default:      2048,
+				Description:  "Size for the generated keys. Only used by rsa-generated keys.",
+			},
+			"elliptic_curve_key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(keycloakRealmKeystoreEcdsaGeneratedEllipticCurve, false),
+				Default:      "P-256",
+				Description:  "Elliptic curve used by the keys. Only used by ecdsa-generated keys.",
+			},
+			"rotation_interval": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateRealmKeyRotationDuration,
+				Description:  "How often a new key is created, as a Go duration such as `720h`.",
+			},
+			"promote_after": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateRealmKeyRotationDuration,
+				Description:  "How long a new key stays passive before it becomes the active signing key.",
+			},
+			"grace_period": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateRealmKeyRotationDuration,
+				Description:  "How long a retired key can still be used to verify tokens before it is removed.",
+			},
+			"delete_retired_keys": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "When false, retired keys are disabled instead of deleted after the grace period.",
+			},
+			"keys": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"kid": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"created_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"promoted_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"retired_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"disabled_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"active_key_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"active_kid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"next_rotation_step_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func validateRealmKeyRotationDuration(value interface{}, key string) ([]string, []error) {
+	duration, err := time.ParseDuration(value.(string))
+	if err != nil {
+		return nil, []error{fmt.Errorf("validation error: %s is not a valid duration: %s", key, err)}
+	}
+
+	if duration < 0 {
+		return nil, []error{fmt.Errorf("validation error: %s must not be negative", key)}
+	}
+
+	return nil, nil
+}
+
+func getRealmKeyRotationScheduleFromData(data interface{ Get(string) interface{} }) (*realmKeyRotationSchedule, error) {
+	rotationInterval, err := time.ParseDuration(data.Get("rotation_interval").(string))
+	if err != nil {
+		return nil, err
+	}
+
+	promoteAfter, err := time.ParseDuration(data.Get("promote_after").(string))
+	if err != nil {
+		return nil, err
+	}
+
+	gracePeriod, err := time.ParseDuration(data.Get("grace_period").(string))
+	if err != nil {
+		return nil, err
+	}
+
+	if rotationInterval <= promoteAfter {
+		return nil, fmt.Errorf("validation error: rotation_interval must be longer than promote_after")
+	}
+
+	return &realmKeyRotationSchedule{
+		RotationInterval: rotationInterval,
+		PromoteAfter:     promoteAfter,
+		GracePeriod:      gracePeriod,
+	}, nil
+}
+
+func parseRealmKeyRotationTime(value interface{}) time.Time {
+	if value == nil || value.(string) == "" {
+		return time.Time{}
+	}
+
+	parsed, err := time.Parse(time.RFC3339, value.(string))
+	if err != nil {
+		return time.Time{}
+	}
+
+	return parsed
+}
+
+func formatRealmKeyRotationTime(value time.Time) string {
+	if value.IsZero() {
+		return ""
+	}
+
+	return value.UTC().Format(time.RFC3339)
+}
+
+func getRealmKeyRotationKeysFromList(keys []interface{}) []*realmKeyRotationKey {
+	var rotationKeys []*realmKeyRotationKey
+	for _, k := range keys {
+		key := k.(map[string]interface{})
+
+		rotationKeys = append(rotationKeys, &realmKeyRotationKey{
+			Id:         key["id"].(string),
+			Name:       key["name"].(string),
+			Kid:        key["kid"].(string),
+			Status:     key["status"].(string),
+			CreatedAt:  parseRealmKeyRotationTime(key["created_at"]),
+			PromotedAt: parseRealmKeyRotationTime(key["promoted_at"]),
+			RetiredAt:  parseRealmKeyRotationTime(key["retired_at"]),
+			DisabledAt: parseRealmKeyRotationTime(key["disabled_at"]),
+		})
+	}
+
+	return rotationKeys
+}
+
+func flattenRealmKeyRotationKeys(keys []*realmKeyRotationKey) []interface{} {
+	var result []interface{}
+	for _, key := range keys {
+		result = append(result, map[string]interface{}{
+			"id":          key.Id,
+			"name":        key.Name,
+			"kid":         key.Kid,
+			"status":      key.Status,
+			"created_at":  formatRealmKeyRotationTime(key.CreatedAt),
+			"promoted_at": formatRealmKeyRotationTime(key.PromotedAt),
+			"retired_at":  formatRealmKeyRotationTime(key.RetiredAt),
+			"disabled_at": formatRealmKeyRotationTime(key.DisabledAt),
+		})
+	}
+
+	return result
+}
+
+// the key that is currently used for signing is the most recently promoted key that hasn't been retired
+func getRealmKeyRotationActiveKey(keys []*realmKeyRotationKey) *realmKeyRotationKey {
+	var activeKey *realmKeyRotationKey
+	for _, key := range keys {
+		if key.PromotedAt.IsZero() || !key.RetiredAt.IsZero() {
+			continue
+		}
+
+		if activeKey == nil || key.PromotedAt.After(activeKey.PromotedAt) {
+			activeKey = key
+		}
+	}
+
+	return activeKey
+}
+
+// the pending key has been created as a passive key, and is waiting to be promoted
+func getRealmKeyRotationPendingKey(keys []*realmKeyRotationKey) *realmKeyRotationKey {
+	for _, key := range keys {
+		if key.PromotedAt.IsZero() && key.RetiredAt.IsZero() {
+			return key
+		}
+	}
+
+	return nil
+}
+
+// getRealmKeyRotationNextStep returns the time of the next step of the rotation, or the zero time if there are no keys,
+// in which case a key needs to be created right away
+func getRealmKeyRotationNextStep(keys []*realmKeyRotationKey, schedule *realmKeyRotationSchedule) time.Time {
+	var steps []time.Time
+
+	pendingKey := getRealmKeyRotationPendingKey(keys)
+	if pendingKey != nil {
+		steps = append(steps, pendingKey.CreatedAt.Add(schedule.PromoteAfter))
+	} else if activeKey := getRealmKeyRotationActiveKey(keys); activeKey != nil {
+		steps = append(steps, activeKey.CreatedAt.Add(schedule.RotationInterval))
+	}
+
+	for _, key := range keys {
+		if !key.RetiredAt.IsZero() && key.DisabledAt.IsZero() {
+			steps = append(steps, key.RetiredAt.Add(schedule.GracePeriod))
+		}
+	}
+
+	var nextStep time.Time
+	for _, step := range steps {
+		if nextStep.IsZero() || step.Before(nextStep) {
+			nextStep = step
+		}
+	}
+
+	return nextStep
+}
+
+func newRealmKeyRotationKeystore(ctx context.Context, keycloakClient *keycloak.KeycloakClient, data *schema.ResourceData, name string, active bool) (string, error) {
+	realmId := data.Get("realm_id").(string)
+
+	switch data.Get("provider_id").(string) {
+	case "ecdsa-generated":
+		realmKey := &keycloak.RealmKeystoreEcdsaGenerated{
+			Name:          name,
+			RealmId:       realmId,
+			Active:        active,
+			Enabled:       true,
+			Priority:      data.Get("priority").(int),
+			EllipticCurve: data.Get("elliptic_curve_key").(string),
+		}
+
+		err := keycloakClient.NewRealmKeystoreEcdsaGenerated(ctx, realmKey)
+
+		return realmKey.Id, err
+	default:
+		realmKey := &keycloak.RealmKeystoreRsaGenerated{
+			Name:      name,
+			RealmId:   realmId,
+			Active:    active,
+			Enabled:   true,
+			Priority:  data.Get("priority").(int),
+			Algorithm: data.Get("algorithm").(string),
+			KeySize:   data.Get("key_size").(int),
+		}
+
+		err := keycloakClient.NewRealmKeystoreRsaGenerated(ctx, realmKey)
+
+		return realmKey.Id, err
+	}
+}
+
+// updateRealmKeyRotationKeystore only changes the flags and the priority of a key. the key material itself is left
+// alone, since changing it would invalidate every token signed by it.
+func updateRealmKeyRotationKeystore(ctx context.Context, keycloakClient *keycloak.KeycloakClient, data *schema.ResourceData, id string, active, enabled bool) error {
+	realmId := data.Get("realm_id").(string)
+
+	switch data.Get("provider_id").(string) {
+	case "ecdsa-generated":
+		realmKey, err := keycloakClient.GetRealmKeystoreEcdsaGenerated(ctx, realmId, id)
+		if err != nil {
+			return err
+		}
+
+		realmKey.Active = active
+		realmKey.Enabled = enabled
+		realmKey.Priority = data.Get("priority").(int)
+
+		return keycloakClient.UpdateRealmKeystoreEcdsaGenerated(ctx, realmKey)
+	default:
+		realmKey, err := keycloakClient.GetRealmKeystoreRsaGenerated(ctx, realmId, id)
+		if err != nil {
+			return err
+		}
+
+		realmKey.Active = active
+		realmKey.Enabled = enabled
+		realmKey.Priority = data.Get("priority").(int)
+
+		return keycloakClient.UpdateRealmKeystoreRsaGenerated(ctx, realmKey)
+	}
+}
+
+func deleteRealmKeyRotationKeystore(ctx context.Context, keycloakClient *keycloak.KeycloakClient, data *schema.ResourceData, id string) error {
+	realmId := data.Get("realm_id").(string)
+
+	var err error
+	switch data.Get("provider_id").(string) {
+	case "ecdsa-generated":
+		err = keycloakClient.DeleteRealmKeystoreEcdsaGenerated(ctx, realmId, id)
+	default:
+		err = keycloakClient.DeleteRealmKeystoreRsaGenerated(ctx, realmId, id)
+	}
+
+	if err != nil && !keycloak.ErrorIs404(err) {
+		return err
+	}
+
+	return nil
+}
+
+// advanceRealmKeyRotation runs every step of the rotation that is due at `now`. a periodic apply that was skipped for a
+// while catches up by running several steps at once.
+func advanceRealmKeyRotation(ctx context.Context, keycloakClient *keycloak.KeycloakClient, data *schema.ResourceData, keys []*realmKeyRotationKey, now time.Time) ([]*realmKeyRotationKey, error) {
+	schedule, err := getRealmKeyRotationScheduleFromData(data)
+	if err != nil {
+		return nil, err
+	}
+
+	namePrefix := data.Get("name_prefix").(string)
+
+	// the first key of a rotation is active right away, since there is nothing to pre-publish it alongside of
+	if getRealmKeyRotationActiveKey(keys) == nil && getRealmKeyRotationPendingKey(keys) == nil {
+		name := fmt.Sprintf("%s-%s", namePrefix, now.UTC().Format(realmKeyRotationNameTimeFormat))
+
+		id, err := newRealmKeyRotationKeystore(ctx, keycloakClient, data, name, true)
+		if err != nil {
+			return nil, err
+		}
+
+		keys = append(keys, &realmKeyRotationKey{
+			Id:         id,
+			Name:       name,
+			CreatedAt:  now,
+			PromotedAt: now,
+		})
+	}
+
+	for {
+		activeKey := getRealmKeyRotationActiveKey(keys)
+		pendingKey := getRealmKeyRotationPendingKey(keys)
+
+		if pendingKey != nil && !now.Before(pendingKey.CreatedAt.Add(schedule.PromoteAfter)) {
+			err := updateRealmKeyRotationKeystore(ctx, keycloakClient, data, pendingKey.Id, true, true)
+			if err != nil {
+				return nil, err
+			}
+
+			pendingKey.PromotedAt = now
+
+			if activeKey != nil {
+				err := updateRealmKeyRotationKeystore(ctx, keycloakClient, data, activeKey.Id, false, true)
+				if err != nil {
+					return nil, err
+				}
+
+				activeKey.RetiredAt = now
+			}
+
+			continue
+		}
+
+		if pendingKey == nil && activeKey != nil && !now.Before(activeKey.CreatedAt.Add(schedule.RotationInterval)) {
+			// the next key is published as a passive key first, so relying parties can pick it up before it's used
+			name := fmt.Sprintf("%s-%s", namePrefix, now.UTC().Format(realmKeyRotationNameTimeFormat))
+
+			id, err := newRealmKeyRotationKeystore(ctx, keycloakClient, data, name, false)
+			if err != nil {
+				return nil, err
+			}
+
+			keys = append(keys, &realmKeyRotationKey{
+				Id:        id,
+				Name:      name,
+				CreatedAt: now,
+			})
+
+			continue
+		}
+
+		break
+	}
+
+	var remainingKeys []*realmKeyRotationKey
+	for _, key := range keys {
+		if key.RetiredAt.IsZero() || !key.DisabledAt.IsZero() || now.Before(key.RetiredAt.Add(schedule.GracePeriod)) {
+			remainingKeys = append(remainingKeys, key)
+			continue
+		}
+
+		if data.Get("delete_retired_keys").(bool) {
+			err := deleteRealmKeyRotationKeystore(ctx, keycloakClient, data, key.Id)
+			if err != nil {
+				return nil, err
+			}
+
+			continue
+		}
+
+		err := updateRealmKeyRotationKeystore(ctx, keycloakClient, data, key.Id, false, false)
+		if err != nil {
+			return nil, err
+		}
+
+		key.DisabledAt = now
+		remainingKeys = append(remainingKeys, key)
+	}
+
+	return remainingKeys, nil
+}
+
+func setRealmKeyRotationData(ctx context.Context, keycloakClient *keycloak.KeycloakClient, data *schema.ResourceData, keys []*realmKeyRotationKey) error {
+	realmKeys, err := keycloakClient.GetRealmKeys(ctx, data.Get("realm_id").(string))
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		for _, realmKey := range realmKeys.Keys {
+			if StringValue(realmKey.ProviderId) == key.Id {
+				key.Kid = StringValue(realmKey.Kid)
+				key.Status = StringValue(realmKey.Status)
+				break
+			}
+		}
+	}
+
+	schedule, err := getRealmKeyRotationScheduleFromData(data)
+	if err != nil {
+		return err
+	}
+
+	data.Set("keys", flattenRealmKeyRotationKeys(keys))
+	data.Set("next_rotation_step_at", formatRealmKeyRotationTime(getRealmKeyRotationNextStep(keys, schedule)))
+
+	if activeKey := getRealmKeyRotationActiveKey(keys); activeKey != nil {
+		data.Set("active_key_id", activeKey.Id)
+		data.Set("active_kid", activeKey.Kid)
+	} else {
+		data.Set("active_key_id", "")
+		data.Set("active_kid", "")
+	}
+
+	return nil
+}
+
+func resourceKeycloakRealmKeyRotationCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	keys, err := advanceRealmKeyRotation(ctx, keycloakClient, data, nil, time.Now().UTC())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(fmt.Sprintf("%s/%s", data.Get("realm_id").(string), data.Get("name_prefix").(string)))
+
+	return diag.FromErr(setRealmKeyRotationData(ctx, keycloakClient, data, keys))
+}
+
+func resourceKeycloakRealmKeyRotationRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+
+	_, err := keycloakClient.GetRealm(ctx, realmId)
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	// keys that were deleted outside of terraform are dropped, which causes the next plan to replace them
+	var keys []*realmKeyRotationKey
+	for _, key := range getRealmKeyRotationKeysFromList(data.Get("keys").([]interface{})) {
+		var err error
+		switch data.Get("provider_id").(string) {
+		case "ecdsa-generated":
+			_, err = keycloakClient.GetRealmKeystoreEcdsaGenerated(ctx, realmId, key.Id)
+		default:
+			_, err = keycloakClient.GetRealmKeystoreRsaGenerated(ctx, realmId, key.Id)
+		}
+
+		if err != nil {
+			if keycloak.ErrorIs404(err) {
+				continue
+			}
+
+			return diag.FromErr(err)
+		}
+
+		keys = append(keys, key)
+	}
+
+	return diag.FromErr(setRealmKeyRotationData(ctx, keycloakClient, data, keys))
+}
+
+func resourceKeycloakRealmKeyRotationUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	oldKeys, _ := data.GetChange("keys")
+	keys := getRealmKeyRotationKeysFromList(oldKeys.([]interface{}))
+
+	if data.HasChange("priority") {
+		for _, key := range keys {
+			active := !key.PromotedAt.IsZero() && key.RetiredAt.IsZero()
+
+			err := updateRealmKeyRotationKeystore(ctx, keycloakClient, data, key.Id, active, key.DisabledAt.IsZero())
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	keys, err := advanceRealmKeyRotation(ctx, keycloakClient, data, keys, time.Now().UTC())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.FromErr(setRealmKeyRotationData(ctx, keycloakClient, data, keys))
+}
+
+func resourceKeycloakRealmKeyRotationDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	for _, key := range getRealmKeyRotationKeysFromList(data.Get("keys").([]interface{})) {
+		err := deleteRealmKeyRotationKeystore(ctx, keycloakClient, data, key.Id)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+// resourceKeycloakRealmKeyRotationCustomizeDiff plans an update once the next step of the rotation is due. without it,
+// a periodic apply would never see a difference between the config and the state.
+func resourceKeycloakRealmKeyRotationCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	rawConfig := d.GetRawConfig()
+	if rawConfig.IsNull() {
+		return nil
+	}
+
+	for _, attribute := range []string{"rotation_interval", "promote_after", "grace_period"} {
+		if !rawConfig.GetAttr(attribute).IsKnown() {
+			return nil
+		}
+	}
+
+	schedule, err := getRealmKeyRotationScheduleFromData(d)
+	if err != nil {
+		return err
+	}
+
+	if d.Id() == "" {
+		return nil
+	}
+
+	keys := getRealmKeyRotationKeysFromList(d.Get("keys").([]interface{}))
+	nextStep := getRealmKeyRotationNextStep(keys, schedule)
+
+	if !nextStep.IsZero() && time.Now().Before(nextStep) {
+		return nil
+	}
+
+	for _, attribute := range []string{"keys", "active_key_id", "active_kid", "next_rotation_step_at"} {
+		err := d.SetNewComputed(attribute)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
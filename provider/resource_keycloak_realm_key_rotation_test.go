@@ -0,0 +1,154 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccKeycloakRealmKeyRotation_basic(t *testing.T) {
+	t.Parallel()
+
+	namePrefix := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakRealmKeyRotationDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealmKeyRotation_basic(namePrefix, "20s", "10s", "10s"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("keycloak_realm_key_rotation.rotation", "keys.#", "1"),
+					resource.TestCheckResourceAttr("keycloak_realm_key_rotation.rotation", "keys.0.status", "ACTIVE"),
+					resource.TestCheckResourceAttrPair("keycloak_realm_key_rotation.rotation", "active_key_id", "keycloak_realm_key_rotation.rotation", "keys.0.id"),
+					resource.TestCheckResourceAttrSet("keycloak_realm_key_rotation.rotation", "active_kid"),
+				),
+			},
+			// after the rotation interval, the next key is published as a passive key
+			{
+				PreConfig: func() { time.Sleep(21 * time.Second) },
+				Config:    testKeycloakRealmKeyRotation_basic(namePrefix, "20s", "10s", "10s"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("keycloak_realm_key_rotation.rotation", "keys.#", "2"),
+					resource.TestCheckResourceAttr("keycloak_realm_key_rotation.rotation", "keys.0.status", "ACTIVE"),
+					resource.TestCheckResourceAttr("keycloak_realm_key_rotation.rotation", "keys.1.status", "PASSIVE"),
+				),
+			},
+			// once promoted, the new key is active and the old key is retired
+			{
+				PreConfig: func() { time.Sleep(11 * time.Second) },
+				Config:    testKeycloakRealmKeyRotation_basic(namePrefix, "20s", "10s", "10s"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("keycloak_realm_key_rotation.rotation", "keys.#", "2"),
+					resource.TestCheckResourceAttr("keycloak_realm_key_rotation.rotation", "keys.0.status", "PASSIVE"),
+					resource.TestCheckResourceAttrSet("keycloak_realm_key_rotation.rotation", "keys.0.retired_at"),
+					resource.TestCheckResourceAttr("keycloak_realm_key_rotation.rotation", "keys.1.status", "ACTIVE"),
+					resource.TestCheckResourceAttrPair("keycloak_realm_key_rotation.rotation", "active_key_id", "keycloak_realm_key_rotation.rotation", "keys.1.id"),
+				),
+			},
+			// after the grace period the retired key is removed
+			{
+				PreConfig: func() { time.Sleep(11 * time.Second) },
+				Config:    testKeycloakRealmKeyRotation_basic(namePrefix, "20s", "10s", "10s"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("keycloak_realm_key_rotation.rotation", "keys.0.status", "ACTIVE"),
+					testAccCheckKeycloakRealmKeyRotationKeyCount("keycloak_realm_key_rotation.rotation", 2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakRealmKeyRotation_invalidSchedule(t *testing.T) {
+	t.Parallel()
+
+	namePrefix := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakRealmKeyRotation_basic(namePrefix, "1h", "2h", "1h"),
+				ExpectError: regexp.MustCompile("rotation_interval must be longer than promote_after"),
+			},
+		},
+	})
+}
+
+// testAccCheckKeycloakRealmKeyRotationKeyCount checks the number of keystores in keycloak that belong to the rotation
+func testAccCheckKeycloakRealmKeyRotationKeyCount(resourceName string, expected int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		realmKeys, err := keycloakClient.GetRealmKeys(testCtx, rs.Primary.Attributes["realm_id"])
+		if err != nil {
+			return err
+		}
+
+		count := 0
+		for _, key := range realmKeys.Keys {
+			for i := 0; i < expected+1; i++ {
+				if StringValue(key.ProviderId) == rs.Primary.Attributes[fmt.Sprintf("keys.%d.id", i)] {
+					count++
+				}
+			}
+		}
+
+		if count != expected {
+			return fmt.Errorf("expected %d keys to belong to the rotation, found %d", expected, count)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKeycloakRealmKeyRotationDestroy() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "keycloak_realm_key_rotation" {
+				continue
+			}
+
+			realmKeys, err := keycloakClient.GetRealmKeys(testCtx, rs.Primary.Attributes["realm_id"])
+			if err != nil {
+				return err
+			}
+
+			for _, key := range realmKeys.Keys {
+				if StringValue(key.ProviderId) == rs.Primary.Attributes["active_key_id"] {
+					return fmt.Errorf("key %s of rotation %s still exists", rs.Primary.Attributes["active_key_id"], rs.Primary.ID)
+				}
+			}
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakRealmKeyRotation_basic(namePrefix, rotationInterval, promoteAfter, gracePeriod string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_realm_key_rotation" "rotation" {
+	realm_id    = data.keycloak_realm.realm.id
+	name_prefix = "%s"
+	priority    = 100
+
+	rotation_interval = "%s"
+	promote_after     = "%s"
+	grace_period      = "%s"
+}
+	`, testAccRealm.Realm, namePrefix, rotationInterval, promoteAfter, gracePeriod)
+}
@@ -0,0 +1,279 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/charlesderek/terraform-w-keycloak/keycloak"
+	"regexp"
+	"strconv"
+	"testing"
+)
+
+func TestAccKeycloakRealmKeystoreEddsaGenerated_basic(t *testing.T) {
+	t.Parallel()
+
+	if ok, _ := keycloakClient.VersionIsGreaterThanOrEqualTo(testCtx, keycloak.Version_23); !ok {
+		t.Skip()
+	}
+
+	eddsaName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckRealmKeystoreEddsaGeneratedDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealmKeystoreEddsaGenerated_basic(eddsaName),
+				Check:  testAccCheckRealmKeystoreEddsaGeneratedExists("keycloak_realm_keystore_eddsa_generated.realm_eddsa"),
+			},
+			{
+				ResourceName:      "keycloak_realm_keystore_eddsa_generated.realm_eddsa",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: getRealmKeystoreGenericImportId("keycloak_realm_keystore_eddsa_generated.realm_eddsa"),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakRealmKeystoreEddsaGenerated_createAfterManualDestroy(t *testing.T) {
+	t.Parallel()
+
+	if ok, _ := keycloakClient.VersionIsGreaterThanOrEqualTo(testCtx, keycloak.Version_23); !ok {
+		t.Skip()
+	}
+
+	var eddsa = &keycloak.RealmKeystoreEddsaGenerated{}
+
+	fullNameKeystoreName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckRealmKeystoreEddsaGeneratedDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealmKeystoreEddsaGenerated_basic(fullNameKeystoreName),
+				Check:  testAccCheckRealmKeystoreEddsaGeneratedFetch("keycloak_realm_keystore_eddsa_generated.realm_eddsa", eddsa),
+			},
+			{
+				PreConfig: func() {
+					err := keycloakClient.DeleteRealmKeystoreEddsaGenerated(testCtx, eddsa.RealmId, eddsa.Id)
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testKeycloakRealmKeystoreEddsaGenerated_basic(fullNameKeystoreName),
+				Check:  testAccCheckRealmKeystoreEddsaGeneratedFetch("keycloak_realm_keystore_eddsa_generated.realm_eddsa", eddsa),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakRealmKeystoreEddsaGenerated_unsupportedVersion(t *testing.T) {
+	t.Parallel()
+
+	if ok, _ := keycloakClient.VersionIsGreaterThanOrEqualTo(testCtx, keycloak.Version_23); ok {
+		t.Skip()
+	}
+
+	eddsaName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckRealmKeystoreEddsaGeneratedDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakRealmKeystoreEddsaGenerated_basic(eddsaName),
+				ExpectError: regexp.MustCompile("eddsa-generated keystores are only supported by Keycloak 23.0.0 and later"),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakRealmKeystoreEddsaGenerated_ellipticCurveValidation(t *testing.T) {
+	t.Parallel()
+
+	if ok, _ := keycloakClient.VersionIsGreaterThanOrEqualTo(testCtx, keycloak.Version_23); !ok {
+		t.Skip()
+	}
+
+	eddsaName := acctest.RandomWithPrefix("tf-acc")
+	ellipticCurve := randomStringInSlice(keycloakRealmKeystoreEddsaGeneratedEllipticCurve)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckRealmKeystoreEddsaGeneratedDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakRealmKeystoreEddsaGenerated_basicWithAttrValidation(eddsaName, "elliptic_curve_key", acctest.RandString(10)),
+				ExpectError: regexp.MustCompile("expected elliptic_curve_key to be one of .+ got .+"),
+			},
+			{
+				Config: testKeycloakRealmKeystoreEddsaGenerated_basicWithAttrValidation(eddsaName, "elliptic_curve_key", ellipticCurve),
+				Check:  testAccCheckRealmKeystoreEddsaGeneratedExists("keycloak_realm_keystore_eddsa_generated.realm_eddsa"),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakRealmKeystoreEddsaGenerated_updateRealmKeystoreEddsaGenerated(t *testing.T) {
+	t.Parallel()
+
+	if ok, _ := keycloakClient.VersionIsGreaterThanOrEqualTo(testCtx, keycloak.Version_23); !ok {
+		t.Skip()
+	}
+
+	enabled := randomBool()
+	active := randomBool()
+
+	groupKeystoreOne := &keycloak.RealmKeystoreEddsaGenerated{
+		Name:          acctest.RandString(10),
+		RealmId:       testAccRealmUserFederation.Realm,
+		Enabled:       enabled,
+		Active:        active,
+		Priority:      acctest.RandIntRange(0, 100),
+		EllipticCurve: randomStringInSlice(keycloakRealmKeystoreEddsaGeneratedEllipticCurve),
+	}
+
+	groupKeystoreTwo := &keycloak.RealmKeystoreEddsaGenerated{
+		Name:          acctest.RandString(10),
+		RealmId:       testAccRealmUserFederation.Realm,
+		Enabled:       enabled,
+		Active:        active,
+		Priority:      acctest.RandIntRange(0, 100),
+		EllipticCurve: randomStringInSlice(keycloakRealmKeystoreEddsaGeneratedEllipticCurve),
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckRealmKeystoreEddsaGeneratedDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealmKeystoreEddsaGenerated_basicFromInterface(groupKeystoreOne),
+				Check:  testAccCheckRealmKeystoreEddsaGeneratedExists("keycloak_realm_keystore_eddsa_generated.realm_eddsa"),
+			},
+			{
+				Config: testKeycloakRealmKeystoreEddsaGenerated_basicFromInterface(groupKeystoreTwo),
+				Check:  testAccCheckRealmKeystoreEddsaGeneratedExists("keycloak_realm_keystore_eddsa_generated.realm_eddsa"),
+			},
+		},
+	})
+}
+
+func testAccCheckRealmKeystoreEddsaGeneratedExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, err := getKeycloakRealmKeystoreEddsaGeneratedFromState(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckRealmKeystoreEddsaGeneratedFetch(resourceName string, keystore *keycloak.RealmKeystoreEddsaGenerated) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		fetchedKeystore, err := getKeycloakRealmKeystoreEddsaGeneratedFromState(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		keystore.Id = fetchedKeystore.Id
+		keystore.RealmId = fetchedKeystore.RealmId
+
+		return nil
+	}
+}
+
+func testAccCheckRealmKeystoreEddsaGeneratedDestroy() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "keycloak_realm_keystore_eddsa_generated" {
+				continue
+			}
+
+			id := rs.Primary.ID
+			realm := rs.Primary.Attributes["realm_id"]
+
+			eddsa, _ := keycloakClient.GetRealmKeystoreEddsaGenerated(testCtx, realm, id)
+			if eddsa != nil {
+				return fmt.Errorf("eddsa keystore with id %s still exists", id)
+			}
+		}
+
+		return nil
+	}
+}
+
+func getKeycloakRealmKeystoreEddsaGeneratedFromState(s *terraform.State,
+	resourceName string) (*keycloak.RealmKeystoreEddsaGenerated,
+	error) {
+	rs, ok := s.RootModule().Resources[resourceName]
+	if !ok {
+		return nil, fmt.Errorf("resource not found: %s", resourceName)
+	}
+
+	id := rs.Primary.ID
+	realm := rs.Primary.Attributes["realm_id"]
+
+	realmKeystore, err := keycloakClient.GetRealmKeystoreEddsaGenerated(testCtx, realm, id)
+	if err != nil {
+		return nil, fmt.Errorf("error getting eddsa keystore with id %s: %s", id, err)
+	}
+
+	return realmKeystore, nil
+}
+
+func testKeycloakRealmKeystoreEddsaGenerated_basic(eddsaName string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_realm_keystore_eddsa_generated" "realm_eddsa" {
+	name      = "%s"
+	realm_id  = data.keycloak_realm.realm.id
+
+    priority           = 100
+    elliptic_curve_key = "Ed448"
+}
+	`, testAccRealmUserFederation.Realm, eddsaName)
+}
+
+func testKeycloakRealmKeystoreEddsaGenerated_basicWithAttrValidation(eddsaName, attr, val string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_realm_keystore_eddsa_generated" "realm_eddsa" {
+	name      = "%s"
+	realm_id  = data.keycloak_realm.realm.id
+
+	%s         = "%s"
+}
+	`, testAccRealmUserFederation.Realm, eddsaName, attr, val)
+}
+
+func testKeycloakRealmKeystoreEddsaGenerated_basicFromInterface(keystore *keycloak.RealmKeystoreEddsaGenerated) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_realm_keystore_eddsa_generated" "realm_eddsa" {
+	name      = "%s"
+	realm_id  = data.keycloak_realm.realm.id
+
+    priority           = "%s"
+    elliptic_curve_key = "%s"
+}
+	`, testAccRealmUserFederation.Realm, keystore.Name, strconv.Itoa(keystore.Priority), keystore.EllipticCurve)
+}
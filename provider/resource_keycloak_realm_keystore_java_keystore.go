@@ -18,10 +18,11 @@ func resourceKeycloakRealmKeystoreJavaKeystore() *schema.Resource {
 		ReadContext:   resourceKeycloakRealmKeystoreJavaKeystoreRead,
 		UpdateContext: resourceKeycloakRealmKeystoreJavaKeystoreUpdate,
 		DeleteContext: resourceKeycloakRealmKeystoreJavaKeystoreDelete,
+		CustomizeDiff: resourceKeycloakRealmKeystoreJavaKeystoreCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceKeycloakRealmKeystoreGenericImport,
 		},
-		Schema: map[string]*schema.Schema{
+		Schema: mergeSchemas(map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -77,7 +78,7 @@ func resourceKeycloakRealmKeystoreJavaKeystore() *schema.Resource {
 				Required:    true,
 				Description: "Password for the private key",
 			},
-		},
+		}, realmKeystoreCertificateSchema()),
 	}
 }
 
@@ -156,7 +157,7 @@ func resourceKeycloakRealmKeystoreJavaKeystoreRead(ctx context.Context, data *sc
 		return diag.FromErr(err)
 	}
 
-	return nil
+	return setRealmKeystoreCertificateData(ctx, keycloakClient, data)
 }
 
 func resourceKeycloakRealmKeystoreJavaKeystoreUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -177,7 +178,7 @@ func resourceKeycloakRealmKeystoreJavaKeystoreUpdate(ctx context.Context, data *
 		return diag.FromErr(err)
 	}
 
-	return nil
+	return setRealmKeystoreCertificateData(ctx, keycloakClient, data)
 }
 
 func resourceKeycloakRealmKeystoreJavaKeystoreDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -188,3 +189,20 @@ func resourceKeycloakRealmKeystoreJavaKeystoreDelete(ctx context.Context, data *
 
 	return diag.FromErr(keycloakClient.DeleteRealmKeystoreJavaKeystore(ctx, realmId, id))
 }
+
+// the certificate of a java keystore can only be read by the server, so the certificate attributes are unknown until the
+// keystore has been updated
+func resourceKeycloakRealmKeystoreJavaKeystoreCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" || !d.HasChanges("keystore", "keystore_password", "key_alias", "key_password") {
+		return nil
+	}
+
+	for _, attribute := range []string{"certificate_not_after", "certificate_subject", "certificate_fingerprint_sha256"} {
+		err := d.SetNewComputed(attribute)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -37,6 +37,30 @@ func TestAccKeycloakRealmKeystoreJava_basic(t *testing.T) {
 	})
 }
 
+func TestAccKeycloakRealmKeystoreJava_certificateAttributes(t *testing.T) {
+	t.Parallel()
+
+	skipIfEnvSet(t, "CI") // temporary while I figure out how to put java keystore file to keycloak container in CI
+
+	javaKeystoreName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckRealmKeystoreJavaDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealmKeystoreJava_basic(javaKeystoreName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("keycloak_realm_keystore_java_keystore.realm_java_keystore", "certificate_subject"),
+					resource.TestCheckResourceAttrSet("keycloak_realm_keystore_java_keystore.realm_java_keystore", "certificate_not_after"),
+					resource.TestMatchResourceAttr("keycloak_realm_keystore_java_keystore.realm_java_keystore", "certificate_fingerprint_sha256", regexp.MustCompile("^[0-9a-f]{64}$")),
+				),
+			},
+		},
+	})
+}
+
 func TestAccKeycloakRealmKeystoreJava_createAfterManualDestroy(t *testing.T) {
 	t.Parallel()
 
@@ -18,10 +18,11 @@ func resourceKeycloakRealmKeystoreRsa() *schema.Resource {
 		ReadContext:   resourceKeycloakRealmKeystoreRsaRead,
 		UpdateContext: resourceKeycloakRealmKeystoreRsaUpdate,
 		DeleteContext: resourceKeycloakRealmKeystoreRsaDelete,
+		CustomizeDiff: validateRealmKeystoreRsaKeyPairCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceKeycloakRealmKeystoreGenericImport,
 		},
-		Schema: map[string]*schema.Schema{
+		Schema: mergeSchemas(map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -67,7 +68,7 @@ func resourceKeycloakRealmKeystoreRsa() *schema.Resource {
 				Required:    true,
 				Description: "X509 Certificate encoded in PEM format",
 			},
-		},
+		}, realmKeystoreCertificateSchema()),
 	}
 }
 
@@ -138,7 +139,7 @@ func resourceKeycloakRealmKeystoreRsaRead(ctx context.Context, data *schema.Reso
 		return diag.FromErr(err)
 	}
 
-	return nil
+	return setRealmKeystoreCertificateData(ctx, keycloakClient, data)
 }
 
 func resourceKeycloakRealmKeystoreRsaUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -156,7 +157,7 @@ func resourceKeycloakRealmKeystoreRsaUpdate(ctx context.Context, data *schema.Re
 		return diag.FromErr(err)
 	}
 
-	return nil
+	return setRealmKeystoreCertificateData(ctx, keycloakClient, data)
 }
 
 func resourceKeycloakRealmKeystoreRsaDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
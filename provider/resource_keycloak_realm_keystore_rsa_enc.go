@@ -0,0 +1,174 @@
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/charlesderek/terraform-w-keycloak/keycloak"
+)
+
+var (
+	keycloakRealmKeystoreRsaEncAlgorithm = []string{"RSA1_5", "RSA-OAEP", "RSA-OAEP-256"}
+)
+
+func resourceKeycloakRealmKeystoreRsaEnc() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKeycloakRealmKeystoreRsaEncCreate,
+		ReadContext:   resourceKeycloakRealmKeystoreRsaEncRead,
+		UpdateContext: resourceKeycloakRealmKeystoreRsaEncUpdate,
+		DeleteContext: resourceKeycloakRealmKeystoreRsaEncDelete,
+		CustomizeDiff: customdiff.All(
+			validateRealmKeystoreRsaKeyPairCustomizeDiff,
+			validateRealmKeystoreAlgorithmCustomizeDiff("rsa-enc"),
+		),
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceKeycloakRealmKeystoreGenericImport,
+		},
+		Schema: mergeSchemas(map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Display name of provider when linked in admin console.",
+			},
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"active": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Set if the keys can be used for encryption",
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Set if the keys are enabled",
+			},
+			"priority": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     0,
+				Description: "Priority for the provider",
+			},
+			"algorithm": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(keycloakRealmKeystoreRsaEncAlgorithm, false),
+				Default:      "RSA-OAEP",
+				Description:  "Intended algorithm for the key",
+			},
+			"private_key": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Private RSA Key encoded in PEM format",
+			},
+			"certificate": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "X509 Certificate encoded in PEM format",
+			},
+		}, realmKeystoreCertificateSchema()),
+	}
+}
+
+func getRealmKeystoreRsaEncFromData(data *schema.ResourceData) *keycloak.RealmKeystoreRsaEnc {
+	mapper := &keycloak.RealmKeystoreRsaEnc{
+		Id:      data.Id(),
+		Name:    data.Get("name").(string),
+		RealmId: data.Get("realm_id").(string),
+
+		Active:      data.Get("active").(bool),
+		Enabled:     data.Get("enabled").(bool),
+		Priority:    data.Get("priority").(int),
+		Algorithm:   data.Get("algorithm").(string),
+		PrivateKey:  data.Get("private_key").(string),
+		Certificate: data.Get("certificate").(string),
+	}
+
+	return mapper
+}
+
+func setRealmKeystoreRsaEncData(data *schema.ResourceData, realmKey *keycloak.RealmKeystoreRsaEnc) {
+	data.SetId(realmKey.Id)
+
+	data.Set("name", realmKey.Name)
+	data.Set("realm_id", realmKey.RealmId)
+
+	data.Set("active", realmKey.Active)
+	data.Set("enabled", realmKey.Enabled)
+	data.Set("priority", realmKey.Priority)
+	data.Set("algorithm", realmKey.Algorithm)
+	if realmKey.PrivateKey != "**********" {
+		data.Set("private_key", realmKey.PrivateKey)
+		data.Set("certificate", realmKey.Certificate)
+	}
+}
+
+func resourceKeycloakRealmKeystoreRsaEncCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmKey := getRealmKeystoreRsaEncFromData(data)
+
+	err := keycloakClient.NewRealmKeystoreRsaEnc(ctx, realmKey)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	setRealmKeystoreRsaEncData(data, realmKey)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceKeycloakRealmKeystoreRsaEncRead(ctx, data, meta)
+}
+
+func resourceKeycloakRealmKeystoreRsaEncRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	id := data.Id()
+
+	realmKey, err := keycloakClient.GetRealmKeystoreRsaEnc(ctx, realmId, id)
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	setRealmKeystoreRsaEncData(data, realmKey)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return setRealmKeystoreCertificateData(ctx, keycloakClient, data)
+}
+
+func resourceKeycloakRealmKeystoreRsaEncUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmKey := getRealmKeystoreRsaEncFromData(data)
+
+	err := keycloakClient.UpdateRealmKeystoreRsaEnc(ctx, realmKey)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	setRealmKeystoreRsaEncData(data, realmKey)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return setRealmKeystoreCertificateData(ctx, keycloakClient, data)
+}
+
+func resourceKeycloakRealmKeystoreRsaEncDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	id := data.Id()
+
+	return diag.FromErr(keycloakClient.DeleteRealmKeystoreRsaEnc(ctx, realmId, id))
+}
@@ -0,0 +1,193 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/charlesderek/terraform-w-keycloak/keycloak"
+	"regexp"
+	"testing"
+)
+
+func TestAccKeycloakRealmKeystoreRsaEnc_basic(t *testing.T) {
+	t.Parallel()
+
+	rsaName := acctest.RandomWithPrefix("tf-acc")
+	privateKey, certificate := generateKeyAndCert(2048)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckRealmKeystoreRsaEncDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealmKeystoreRsaEnc_basic(rsaName, privateKey, certificate),
+				Check:  testAccCheckRealmKeystoreRsaEncExists("keycloak_realm_keystore_rsa_enc.realm_rsa_enc"),
+			},
+			// we can't verify this import test because there's no way to get the private key / cert from the Keycloak API
+			{
+				ResourceName:      "keycloak_realm_keystore_rsa_enc.realm_rsa_enc",
+				ImportState:       true,
+				ImportStateIdFunc: getRealmKeystoreGenericImportId("keycloak_realm_keystore_rsa_enc.realm_rsa_enc"),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakRealmKeystoreRsaEnc_createAfterManualDestroy(t *testing.T) {
+	t.Parallel()
+
+	var keystoreRsaEnc = &keycloak.RealmKeystoreRsaEnc{}
+
+	fullNameKeystoreName := acctest.RandomWithPrefix("tf-acc")
+	privateKey, certificate := generateKeyAndCert(2048)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckRealmKeystoreRsaEncDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealmKeystoreRsaEnc_basic(fullNameKeystoreName, privateKey, certificate),
+				Check:  testAccCheckRealmKeystoreRsaEncFetch("keycloak_realm_keystore_rsa_enc.realm_rsa_enc", keystoreRsaEnc),
+			},
+			{
+				PreConfig: func() {
+					err := keycloakClient.DeleteRealmKeystoreRsaEnc(testCtx, keystoreRsaEnc.RealmId, keystoreRsaEnc.Id)
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testKeycloakRealmKeystoreRsaEnc_basic(fullNameKeystoreName, privateKey, certificate),
+				Check:  testAccCheckRealmKeystoreRsaEncFetch("keycloak_realm_keystore_rsa_enc.realm_rsa_enc", keystoreRsaEnc),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakRealmKeystoreRsaEnc_algorithmValidation(t *testing.T) {
+	t.Parallel()
+
+	algorithm := randomStringInSlice(keycloakRealmKeystoreRsaEncAlgorithm)
+	privateKey, certificate := generateKeyAndCert(2048)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckRealmKeystoreRsaEncDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealmKeystoreRsaEnc_basicWithAttrValidation(algorithm, "algorithm",
+					acctest.RandString(10), privateKey, certificate),
+				ExpectError: regexp.MustCompile("expected algorithm to be one of .+ got .+"),
+			},
+			{
+				Config: testKeycloakRealmKeystoreRsaEnc_basicWithAttrValidation(algorithm, "algorithm", algorithm,
+					privateKey, certificate),
+				Check: testAccCheckRealmKeystoreRsaEncExists("keycloak_realm_keystore_rsa_enc.realm_rsa_enc"),
+			},
+		},
+	})
+}
+
+func testAccCheckRealmKeystoreRsaEncExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, err := getKeycloakRealmKeystoreRsaEncFromState(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckRealmKeystoreRsaEncFetch(resourceName string, keystore *keycloak.RealmKeystoreRsaEnc) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		fetchedKeystore, err := getKeycloakRealmKeystoreRsaEncFromState(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		keystore.Id = fetchedKeystore.Id
+		keystore.RealmId = fetchedKeystore.RealmId
+
+		return nil
+	}
+}
+
+func testAccCheckRealmKeystoreRsaEncDestroy() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "keycloak_realm_keystore_rsa_enc" {
+				continue
+			}
+
+			id := rs.Primary.ID
+			realm := rs.Primary.Attributes["realm_id"]
+			keystoreRsaEnc, _ := keycloakClient.GetRealmKeystoreRsaEnc(testCtx, realm, id)
+			if keystoreRsaEnc != nil {
+				return fmt.Errorf("rsa keystore with id %s still exists", id)
+			}
+		}
+
+		return nil
+	}
+}
+
+func getKeycloakRealmKeystoreRsaEncFromState(s *terraform.State,
+	resourceName string) (*keycloak.RealmKeystoreRsaEnc,
+	error) {
+	rs, ok := s.RootModule().Resources[resourceName]
+	if !ok {
+		return nil, fmt.Errorf("resource not found: %s", resourceName)
+	}
+
+	id := rs.Primary.ID
+	realm := rs.Primary.Attributes["realm_id"]
+
+	realmKeystore, err := keycloakClient.GetRealmKeystoreRsaEnc(testCtx, realm, id)
+	if err != nil {
+		return nil, fmt.Errorf("error getting rsa keystore with id %s: %s", id, err)
+	}
+
+	return realmKeystore, nil
+}
+
+func testKeycloakRealmKeystoreRsaEnc_basic(rsaName, privateKey, certificate string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_realm_keystore_rsa_enc" "realm_rsa_enc" {
+
+	name      = "%s"
+	realm_id  = data.keycloak_realm.realm.id
+
+    priority    = 100
+    algorithm   = "RSA-OAEP"
+    private_key = "%s"
+    certificate = "%s"
+}
+	`, testAccRealmUserFederation.Realm, rsaName, privateKey, certificate)
+}
+
+func testKeycloakRealmKeystoreRsaEnc_basicWithAttrValidation(rsaName, attr, val, privateKey,
+	certificate string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_realm_keystore_rsa_enc" "realm_rsa_enc" {
+	name      = "%s"
+	realm_id  = data.keycloak_realm.realm.id
+
+	%s        = "%s"
+
+    private_key = "%s"
+    certificate = "%s"
+}
+	`, testAccRealmUserFederation.Realm, rsaName, attr, val, privateKey, certificate)
+}
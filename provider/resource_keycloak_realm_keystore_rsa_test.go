@@ -100,6 +100,68 @@ func TestAccKeycloakRealmKeystoreRsa_algorithmValidation(t *testing.T) {
 	})
 }
 
+func TestAccKeycloakRealmKeystoreRsa_certificateAttributes(t *testing.T) {
+	t.Parallel()
+
+	rsaName := acctest.RandomWithPrefix("tf-acc")
+	privateKey, certificate := generateKeyAndCert(2048)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckRealmKeystoreRsaDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealmKeystoreRsa_basic(rsaName, privateKey, certificate),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("keycloak_realm_keystore_rsa.realm_rsa", "certificate_subject", "CN=New Name,O=New Org."),
+					resource.TestCheckResourceAttrSet("keycloak_realm_keystore_rsa.realm_rsa", "certificate_not_after"),
+					resource.TestMatchResourceAttr("keycloak_realm_keystore_rsa.realm_rsa", "certificate_fingerprint_sha256", regexp.MustCompile("^[0-9a-f]{64}$")),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakRealmKeystoreRsa_mismatchedKeyPair(t *testing.T) {
+	t.Parallel()
+
+	rsaName := acctest.RandomWithPrefix("tf-acc")
+	privateKey, _ := generateKeyAndCert(2048)
+	_, certificate := generateKeyAndCert(2048)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckRealmKeystoreRsaDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakRealmKeystoreRsa_basic(rsaName, privateKey, certificate),
+				ExpectError: regexp.MustCompile("private key does not match the public key of the certificate"),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakRealmKeystoreRsa_keyTooSmallForAlgorithm(t *testing.T) {
+	t.Parallel()
+
+	rsaName := acctest.RandomWithPrefix("tf-acc")
+	privateKey, certificate := generateKeyAndCert(1024)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckRealmKeystoreRsaDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakRealmKeystoreRsa_basicWithAttrValidation(rsaName, "algorithm", "PS512", privateKey, certificate),
+				ExpectError: regexp.MustCompile("a 1024 bit key is too small to be used with PS512"),
+			},
+		},
+	})
+}
+
 func testAccCheckRealmKeystoreRsaExists(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		_, err := getKeycloakRealmKeystoreRsaFromState(s, resourceName)
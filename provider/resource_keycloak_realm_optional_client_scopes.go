@@ -0,0 +1,116 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/charlesderek/terraform-w-keycloak/keycloak"
+)
+
+func resourceKeycloakRealmOptionalClientScopes() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKeycloakRealmOptionalClientScopesReconcile,
+		ReadContext:   resourceKeycloakRealmOptionalClientScopesRead,
+		DeleteContext: resourceKeycloakRealmOptionalClientScopesDelete,
+		UpdateContext: resourceKeycloakRealmOptionalClientScopesReconcile,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceKeycloakRealmOptionalClientScopesImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"optional_scopes": {
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Required:    true,
+				Set:         schema.HashString,
+				Description: "The names of the openid-connect and saml client scopes that are attached to new clients as optional scopes.",
+			},
+		},
+	}
+}
+
+func resourceKeycloakRealmOptionalClientScopesRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+
+	clientScopes, err := keycloakClient.GetRealmOptionalClientScopes(ctx, realmId)
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	var optionalScopes []string
+	for _, clientScope := range clientScopes {
+		optionalScopes = append(optionalScopes, clientScope.Name)
+	}
+
+	data.Set("optional_scopes", optionalScopes)
+	data.SetId(realmId)
+
+	return nil
+}
+
+func resourceKeycloakRealmOptionalClientScopesReconcile(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	tfRealmOptionalClientScopes := data.Get("optional_scopes").(*schema.Set)
+
+	keycloakRealmOptionalClientScopes, err := keycloakClient.GetRealmOptionalClientScopes(ctx, realmId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var realmOptionalClientScopesToDetach []string
+	for _, keycloakRealmOptionalClientScope := range keycloakRealmOptionalClientScopes {
+		// if this scope is attached in keycloak and tf state, no update is required
+		// remove it from the set so we can look at scopes that need to be attached later
+		if tfRealmOptionalClientScopes.Contains(keycloakRealmOptionalClientScope.Name) {
+			tfRealmOptionalClientScopes.Remove(keycloakRealmOptionalClientScope.Name)
+		} else {
+			// if this scope is attached in keycloak but not in tf state, add them to a slice containing all scopes to detach
+			realmOptionalClientScopesToDetach = append(realmOptionalClientScopesToDetach, keycloakRealmOptionalClientScope.Name)
+		}
+	}
+
+	// detach scopes that aren't in tf state
+	err = keycloakClient.DetachRealmOptionalClientScopes(ctx, realmId, realmOptionalClientScopesToDetach)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// attach scopes that exist in tf state but not in keycloak
+	err = keycloakClient.AttachRealmOptionalClientScopes(ctx, realmId, interfaceSliceToStringSlice(tfRealmOptionalClientScopes.List()))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(realmId)
+
+	return resourceKeycloakRealmOptionalClientScopesRead(ctx, data, meta)
+}
+
+func resourceKeycloakRealmOptionalClientScopesDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	optionalScopes := data.Get("optional_scopes").(*schema.Set)
+
+	return diag.FromErr(keycloakClient.DetachRealmOptionalClientScopes(ctx, realmId, interfaceSliceToStringSlice(optionalScopes.List())))
+}
+
+func resourceKeycloakRealmOptionalClientScopesImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("realm_id", d.Id())
+
+	diagnostics := resourceKeycloakRealmOptionalClientScopesRead(ctx, d, meta)
+	if diagnostics.HasError() {
+		return nil, errors.New(diagnostics[0].Summary)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
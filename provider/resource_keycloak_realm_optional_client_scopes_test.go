@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccKeycloakRealmOptionalClientScopes_basic(t *testing.T) {
+	t.Parallel()
+	realmName := acctest.RandomWithPrefix("tf-acc")
+	openidClientScope := acctest.RandomWithPrefix("tf-acc")
+	samlClientScope := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakRealmDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealmOptionalClientScopes(realmName, openidClientScope, samlClientScope, []string{openidClientScope, samlClientScope}),
+				Check:  testAccCheckKeycloakRealmHasOptionalClientScopes(realmName, []string{openidClientScope, samlClientScope}),
+			},
+			{
+				ResourceName:      "keycloak_realm_optional_client_scopes.optional_scopes",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testKeycloakRealmOptionalClientScopes(realmName, openidClientScope, samlClientScope, []string{samlClientScope}),
+				Check:  testAccCheckKeycloakRealmHasOptionalClientScopes(realmName, []string{samlClientScope}),
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakRealmHasOptionalClientScopes(realmName string, expectedScopes []string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		clientScopes, err := keycloakClient.GetRealmOptionalClientScopes(testCtx, realmName)
+		if err != nil {
+			return err
+		}
+
+		var scopes []string
+		for _, clientScope := range clientScopes {
+			scopes = append(scopes, clientScope.Name)
+		}
+
+		sort.Strings(scopes)
+		sort.Strings(expectedScopes)
+
+		if strings.Join(scopes, ",") != strings.Join(expectedScopes, ",") {
+			return fmt.Errorf("expected realm %s to have optional client scopes %v, got %v", realmName, expectedScopes, scopes)
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakRealmOptionalClientScopes(realmName, openidClientScope, samlClientScope string, optionalScopes []string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client_scope" "openid_client_scope" {
+	realm_id = keycloak_realm.realm.id
+	name     = "%s"
+}
+
+resource "keycloak_saml_client_scope" "saml_client_scope" {
+	realm_id = keycloak_realm.realm.id
+	name     = "%s"
+}
+
+resource "keycloak_realm_optional_client_scopes" "optional_scopes" {
+	realm_id       = keycloak_realm.realm.id
+	optional_scopes = %s
+
+	depends_on = [
+		keycloak_openid_client_scope.openid_client_scope,
+		keycloak_saml_client_scope.saml_client_scope,
+	]
+}
+	`, realmName, openidClientScope, samlClientScope, arrayOfStringsForTerraformResource(optionalScopes))
+}
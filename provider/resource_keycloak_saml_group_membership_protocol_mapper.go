@@ -0,0 +1,174 @@
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/charlesderek/terraform-w-keycloak/keycloak"
+)
+
+func resourceKeycloakSamlGroupMembershipProtocolMapper() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKeycloakSamlGroupMembershipProtocolMapperCreate,
+		ReadContext:   resourceKeycloakSamlGroupMembershipProtocolMapperRead,
+		UpdateContext: resourceKeycloakSamlGroupMembershipProtocolMapperUpdate,
+		DeleteContext: resourceKeycloakSamlGroupMembershipProtocolMapperDelete,
+		Importer: &schema.ResourceImporter{
+			// import a mapper tied to a client:
+			// {{realmId}}/client/{{clientId}}/{{protocolMapperId}}
+			// or a client scope:
+			// {{realmId}}/client-scope/{{clientScopeId}}/{{protocolMapperId}}
+			StateContext: genericProtocolMapperImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"client_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"client_scope_id"},
+			},
+			"client_scope_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"client_id"},
+			},
+			"single_group_attribute": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "When enabled, all groups are added as values of a single attribute. Otherwise, each group is added as a separate attribute.",
+			},
+			"full_path": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Indicates whether the full path of the group, such as /top/level, is used instead of its name.",
+			},
+			"friendly_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"saml_attribute_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"saml_attribute_name_format": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "Basic",
+				ValidateFunc: validation.StringInSlice(keycloakSamlUserAttributeProtocolMapperNameFormats, false),
+			},
+		},
+	}
+}
+
+func mapFromDataToSamlGroupMembershipProtocolMapper(data *schema.ResourceData) *keycloak.SamlGroupMembershipProtocolMapper {
+	return &keycloak.SamlGroupMembershipProtocolMapper{
+		Id:            data.Id(),
+		Name:          data.Get("name").(string),
+		RealmId:       data.Get("realm_id").(string),
+		ClientId:      data.Get("client_id").(string),
+		ClientScopeId: data.Get("client_scope_id").(string),
+
+		SingleGroupAttribute: data.Get("single_group_attribute").(bool),
+		FullPath:             data.Get("full_path").(bool),
+
+		FriendlyName:            data.Get("friendly_name").(string),
+		SamlAttributeName:       data.Get("saml_attribute_name").(string),
+		SamlAttributeNameFormat: data.Get("saml_attribute_name_format").(string),
+	}
+}
+
+func mapFromSamlGroupMembershipMapperToData(mapper *keycloak.SamlGroupMembershipProtocolMapper, data *schema.ResourceData) {
+	data.SetId(mapper.Id)
+	data.Set("name", mapper.Name)
+	data.Set("realm_id", mapper.RealmId)
+
+	if mapper.ClientId != "" {
+		data.Set("client_id", mapper.ClientId)
+	} else {
+		data.Set("client_scope_id", mapper.ClientScopeId)
+	}
+
+	data.Set("single_group_attribute", mapper.SingleGroupAttribute)
+	data.Set("full_path", mapper.FullPath)
+	data.Set("friendly_name", mapper.FriendlyName)
+	data.Set("saml_attribute_name", mapper.SamlAttributeName)
+	data.Set("saml_attribute_name_format", mapper.SamlAttributeNameFormat)
+}
+
+func resourceKeycloakSamlGroupMembershipProtocolMapperCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	samlGroupMembershipMapper := mapFromDataToSamlGroupMembershipProtocolMapper(data)
+
+	err := keycloakClient.ValidateSamlGroupMembershipProtocolMapper(ctx, samlGroupMembershipMapper)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = keycloakClient.NewSamlGroupMembershipProtocolMapper(ctx, samlGroupMembershipMapper)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	mapFromSamlGroupMembershipMapperToData(samlGroupMembershipMapper, data)
+
+	return resourceKeycloakSamlGroupMembershipProtocolMapperRead(ctx, data, meta)
+}
+
+func resourceKeycloakSamlGroupMembershipProtocolMapperRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	clientId := data.Get("client_id").(string)
+	clientScopeId := data.Get("client_scope_id").(string)
+
+	samlGroupMembershipMapper, err := keycloakClient.GetSamlGroupMembershipProtocolMapper(ctx, realmId, clientId, clientScopeId, data.Id())
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	mapFromSamlGroupMembershipMapperToData(samlGroupMembershipMapper, data)
+
+	return nil
+}
+
+func resourceKeycloakSamlGroupMembershipProtocolMapperUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	samlGroupMembershipMapper := mapFromDataToSamlGroupMembershipProtocolMapper(data)
+
+	err := keycloakClient.ValidateSamlGroupMembershipProtocolMapper(ctx, samlGroupMembershipMapper)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = keycloakClient.UpdateSamlGroupMembershipProtocolMapper(ctx, samlGroupMembershipMapper)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceKeycloakSamlGroupMembershipProtocolMapperRead(ctx, data, meta)
+}
+
+func resourceKeycloakSamlGroupMembershipProtocolMapperDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	clientId := data.Get("client_id").(string)
+	clientScopeId := data.Get("client_scope_id").(string)
+
+	return diag.FromErr(keycloakClient.DeleteSamlGroupMembershipProtocolMapper(ctx, realmId, clientId, clientScopeId, data.Id()))
+}
@@ -0,0 +1,216 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/charlesderek/terraform-w-keycloak/keycloak"
+)
+
+func TestAccKeycloakSamlGroupMembershipProtocolMapper_import(t *testing.T) {
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
+	clientScopeId := acctest.RandomWithPrefix("tf-acc")
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+
+	clientResourceName := "keycloak_saml_group_membership_protocol_mapper.group_membership_mapper_client"
+	clientScopeResourceName := "keycloak_saml_group_membership_protocol_mapper.group_membership_mapper_client_scope"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccKeycloakSamlGroupMembershipProtocolMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakSamlGroupMembershipProtocolMapper_import(clientId, clientScopeId, mapperName),
+				Check: resource.ComposeTestCheckFunc(
+					testKeycloakSamlGroupMembershipProtocolMapperExists(clientResourceName),
+					testKeycloakSamlGroupMembershipProtocolMapperExists(clientScopeResourceName),
+				),
+			},
+			{
+				ResourceName:      clientResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: getGenericProtocolMapperIdForClient(clientResourceName),
+			},
+			{
+				ResourceName:      clientScopeResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: getGenericProtocolMapperIdForClientScope(clientScopeResourceName),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakSamlGroupMembershipProtocolMapper_update(t *testing.T) {
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+
+	resourceName := "keycloak_saml_group_membership_protocol_mapper.group_membership_mapper"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccKeycloakSamlGroupMembershipProtocolMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakSamlGroupMembershipProtocolMapper_fullPath(clientId, mapperName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testKeycloakSamlGroupMembershipProtocolMapperExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "full_path", "true"),
+				),
+			},
+			{
+				Config: testKeycloakSamlGroupMembershipProtocolMapper_fullPath(clientId, mapperName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testKeycloakSamlGroupMembershipProtocolMapperExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "full_path", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakSamlGroupMembershipProtocolMapper_validateNameFormat(t *testing.T) {
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccKeycloakSamlGroupMembershipProtocolMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakSamlGroupMembershipProtocolMapper_nameFormat(clientId, mapperName, "Unknown"),
+				ExpectError: regexp.MustCompile("expected saml_attribute_name_format to be one of"),
+			},
+			{
+				Config:      testKeycloakSamlGroupMembershipProtocolMapper_nameFormat(clientId, mapperName, "URI Reference"),
+				ExpectError: regexp.MustCompile("validation error: saml attribute name .+ must be an absolute uri when the name format is URI Reference"),
+			},
+		},
+	})
+}
+
+func testAccKeycloakSamlGroupMembershipProtocolMapperDestroy() resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		for resourceName, rs := range state.RootModule().Resources {
+			if rs.Type != "keycloak_saml_group_membership_protocol_mapper" {
+				continue
+			}
+
+			mapper, _ := getSamlGroupMembershipMapperUsingState(state, resourceName)
+
+			if mapper != nil {
+				return fmt.Errorf("saml group membership protocol mapper with id %s still exists", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakSamlGroupMembershipProtocolMapperExists(resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		_, err := getSamlGroupMembershipMapperUsingState(state, resourceName)
+
+		return err
+	}
+}
+
+func getSamlGroupMembershipMapperUsingState(state *terraform.State, resourceName string) (*keycloak.SamlGroupMembershipProtocolMapper, error) {
+	rs, ok := state.RootModule().Resources[resourceName]
+	if !ok {
+		return nil, fmt.Errorf("resource not found in TF state: %s ", resourceName)
+	}
+
+	id := rs.Primary.ID
+	realm := rs.Primary.Attributes["realm_id"]
+	clientId := rs.Primary.Attributes["client_id"]
+	clientScopeId := rs.Primary.Attributes["client_scope_id"]
+
+	return keycloakClient.GetSamlGroupMembershipProtocolMapper(testCtx, realm, clientId, clientScopeId, id)
+}
+
+func testKeycloakSamlGroupMembershipProtocolMapper_import(clientId, clientScopeId, mapperName string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_saml_client" "saml_client" {
+	realm_id  = data.keycloak_realm.realm.id
+	client_id = "%s"
+}
+
+resource "keycloak_saml_group_membership_protocol_mapper" "group_membership_mapper_client" {
+	name      = "%s"
+	realm_id  = data.keycloak_realm.realm.id
+	client_id = keycloak_saml_client.saml_client.id
+
+	saml_attribute_name = "member"
+}
+
+resource "keycloak_saml_client_scope" "client_scope" {
+	name     = "%s"
+	realm_id = data.keycloak_realm.realm.id
+}
+
+resource "keycloak_saml_group_membership_protocol_mapper" "group_membership_mapper_client_scope" {
+	name            = "%s"
+	realm_id        = data.keycloak_realm.realm.id
+	client_scope_id = keycloak_saml_client_scope.client_scope.id
+
+	saml_attribute_name = "member"
+}`, testAccRealm.Realm, clientId, mapperName, clientScopeId, mapperName)
+}
+
+func testKeycloakSamlGroupMembershipProtocolMapper_fullPath(clientId, mapperName string, fullPath bool) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_saml_client" "saml_client" {
+	realm_id  = data.keycloak_realm.realm.id
+	client_id = "%s"
+}
+
+resource "keycloak_saml_group_membership_protocol_mapper" "group_membership_mapper" {
+	name      = "%s"
+	realm_id  = data.keycloak_realm.realm.id
+	client_id = keycloak_saml_client.saml_client.id
+
+	saml_attribute_name = "member"
+	full_path           = %t
+}`, testAccRealm.Realm, clientId, mapperName, fullPath)
+}
+
+func testKeycloakSamlGroupMembershipProtocolMapper_nameFormat(clientId, mapperName, nameFormat string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_saml_client" "saml_client" {
+	realm_id  = data.keycloak_realm.realm.id
+	client_id = "%s"
+}
+
+resource "keycloak_saml_group_membership_protocol_mapper" "group_membership_mapper" {
+	name      = "%s"
+	realm_id  = data.keycloak_realm.realm.id
+	client_id = keycloak_saml_client.saml_client.id
+
+	saml_attribute_name        = "member"
+	saml_attribute_name_format = "%s"
+}`, testAccRealm.Realm, clientId, mapperName, nameFormat)
+}
@@ -0,0 +1,219 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/charlesderek/terraform-w-keycloak/keycloak"
+)
+
+func TestAccKeycloakSamlHardcodedAttributeProtocolMapper_import(t *testing.T) {
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
+	clientScopeId := acctest.RandomWithPrefix("tf-acc")
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+
+	clientResourceName := "keycloak_saml_hardcoded_attribute_protocol_mapper.hardcoded_attribute_mapper_client"
+	clientScopeResourceName := "keycloak_saml_hardcoded_attribute_protocol_mapper.hardcoded_attribute_mapper_client_scope"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccKeycloakSamlHardcodedAttributeProtocolMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakSamlHardcodedAttributeProtocolMapper_import(clientId, clientScopeId, mapperName),
+				Check: resource.ComposeTestCheckFunc(
+					testKeycloakSamlHardcodedAttributeProtocolMapperExists(clientResourceName),
+					testKeycloakSamlHardcodedAttributeProtocolMapperExists(clientScopeResourceName),
+				),
+			},
+			{
+				ResourceName:      clientResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: getGenericProtocolMapperIdForClient(clientResourceName),
+			},
+			{
+				ResourceName:      clientScopeResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: getGenericProtocolMapperIdForClientScope(clientScopeResourceName),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakSamlHardcodedAttributeProtocolMapper_update(t *testing.T) {
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+
+	resourceName := "keycloak_saml_hardcoded_attribute_protocol_mapper.hardcoded_attribute_mapper"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccKeycloakSamlHardcodedAttributeProtocolMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakSamlHardcodedAttributeProtocolMapper_attributeValue(clientId, mapperName, "bar"),
+				Check: resource.ComposeTestCheckFunc(
+					testKeycloakSamlHardcodedAttributeProtocolMapperExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "attribute_value", "bar"),
+				),
+			},
+			{
+				Config: testKeycloakSamlHardcodedAttributeProtocolMapper_attributeValue(clientId, mapperName, "baz"),
+				Check: resource.ComposeTestCheckFunc(
+					testKeycloakSamlHardcodedAttributeProtocolMapperExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "attribute_value", "baz"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakSamlHardcodedAttributeProtocolMapper_validateNameFormat(t *testing.T) {
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccKeycloakSamlHardcodedAttributeProtocolMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakSamlHardcodedAttributeProtocolMapper_nameFormat(clientId, mapperName, "Unknown"),
+				ExpectError: regexp.MustCompile("expected saml_attribute_name_format to be one of"),
+			},
+			{
+				Config:      testKeycloakSamlHardcodedAttributeProtocolMapper_nameFormat(clientId, mapperName, "URI Reference"),
+				ExpectError: regexp.MustCompile("validation error: saml attribute name .+ must be an absolute uri when the name format is URI Reference"),
+			},
+		},
+	})
+}
+
+func testAccKeycloakSamlHardcodedAttributeProtocolMapperDestroy() resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		for resourceName, rs := range state.RootModule().Resources {
+			if rs.Type != "keycloak_saml_hardcoded_attribute_protocol_mapper" {
+				continue
+			}
+
+			mapper, _ := getSamlHardcodedAttributeMapperUsingState(state, resourceName)
+
+			if mapper != nil {
+				return fmt.Errorf("saml hardcoded attribute protocol mapper with id %s still exists", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakSamlHardcodedAttributeProtocolMapperExists(resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		_, err := getSamlHardcodedAttributeMapperUsingState(state, resourceName)
+
+		return err
+	}
+}
+
+func getSamlHardcodedAttributeMapperUsingState(state *terraform.State, resourceName string) (*keycloak.SamlHardcodedAttributeProtocolMapper, error) {
+	rs, ok := state.RootModule().Resources[resourceName]
+	if !ok {
+		return nil, fmt.Errorf("resource not found in TF state: %s ", resourceName)
+	}
+
+	id := rs.Primary.ID
+	realm := rs.Primary.Attributes["realm_id"]
+	clientId := rs.Primary.Attributes["client_id"]
+	clientScopeId := rs.Primary.Attributes["client_scope_id"]
+
+	return keycloakClient.GetSamlHardcodedAttributeProtocolMapper(testCtx, realm, clientId, clientScopeId, id)
+}
+
+func testKeycloakSamlHardcodedAttributeProtocolMapper_import(clientId, clientScopeId, mapperName string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_saml_client" "saml_client" {
+	realm_id  = data.keycloak_realm.realm.id
+	client_id = "%s"
+}
+
+resource "keycloak_saml_hardcoded_attribute_protocol_mapper" "hardcoded_attribute_mapper_client" {
+	name      = "%s"
+	realm_id  = data.keycloak_realm.realm.id
+	client_id = keycloak_saml_client.saml_client.id
+
+	saml_attribute_name = "foo"
+	attribute_value     = "bar"
+}
+
+resource "keycloak_saml_client_scope" "client_scope" {
+	name     = "%s"
+	realm_id = data.keycloak_realm.realm.id
+}
+
+resource "keycloak_saml_hardcoded_attribute_protocol_mapper" "hardcoded_attribute_mapper_client_scope" {
+	name            = "%s"
+	realm_id        = data.keycloak_realm.realm.id
+	client_scope_id = keycloak_saml_client_scope.client_scope.id
+
+	saml_attribute_name = "foo"
+	attribute_value     = "bar"
+}`, testAccRealm.Realm, clientId, mapperName, clientScopeId, mapperName)
+}
+
+func testKeycloakSamlHardcodedAttributeProtocolMapper_attributeValue(clientId, mapperName, attributeValue string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_saml_client" "saml_client" {
+	realm_id  = data.keycloak_realm.realm.id
+	client_id = "%s"
+}
+
+resource "keycloak_saml_hardcoded_attribute_protocol_mapper" "hardcoded_attribute_mapper" {
+	name      = "%s"
+	realm_id  = data.keycloak_realm.realm.id
+	client_id = keycloak_saml_client.saml_client.id
+
+	saml_attribute_name = "foo"
+	attribute_value     = "%s"
+}`, testAccRealm.Realm, clientId, mapperName, attributeValue)
+}
+
+func testKeycloakSamlHardcodedAttributeProtocolMapper_nameFormat(clientId, mapperName, nameFormat string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_saml_client" "saml_client" {
+	realm_id  = data.keycloak_realm.realm.id
+	client_id = "%s"
+}
+
+resource "keycloak_saml_hardcoded_attribute_protocol_mapper" "hardcoded_attribute_mapper" {
+	name      = "%s"
+	realm_id  = data.keycloak_realm.realm.id
+	client_id = keycloak_saml_client.saml_client.id
+
+	saml_attribute_name        = "foo"
+	attribute_value            = "bar"
+	saml_attribute_name_format = "%s"
+}`, testAccRealm.Realm, clientId, mapperName, nameFormat)
+}
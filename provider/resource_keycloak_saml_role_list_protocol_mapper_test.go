@@ -0,0 +1,216 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/charlesderek/terraform-w-keycloak/keycloak"
+)
+
+func TestAccKeycloakSamlRoleListProtocolMapper_import(t *testing.T) {
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
+	clientScopeId := acctest.RandomWithPrefix("tf-acc")
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+
+	clientResourceName := "keycloak_saml_role_list_protocol_mapper.role_list_mapper_client"
+	clientScopeResourceName := "keycloak_saml_role_list_protocol_mapper.role_list_mapper_client_scope"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccKeycloakSamlRoleListProtocolMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakSamlRoleListProtocolMapper_import(clientId, clientScopeId, mapperName),
+				Check: resource.ComposeTestCheckFunc(
+					testKeycloakSamlRoleListProtocolMapperExists(clientResourceName),
+					testKeycloakSamlRoleListProtocolMapperExists(clientScopeResourceName),
+				),
+			},
+			{
+				ResourceName:      clientResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: getGenericProtocolMapperIdForClient(clientResourceName),
+			},
+			{
+				ResourceName:      clientScopeResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: getGenericProtocolMapperIdForClientScope(clientScopeResourceName),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakSamlRoleListProtocolMapper_update(t *testing.T) {
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+
+	resourceName := "keycloak_saml_role_list_protocol_mapper.role_list_mapper"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccKeycloakSamlRoleListProtocolMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakSamlRoleListProtocolMapper_singleRoleAttribute(clientId, mapperName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testKeycloakSamlRoleListProtocolMapperExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "single_role_attribute", "false"),
+				),
+			},
+			{
+				Config: testKeycloakSamlRoleListProtocolMapper_singleRoleAttribute(clientId, mapperName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testKeycloakSamlRoleListProtocolMapperExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "single_role_attribute", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakSamlRoleListProtocolMapper_validateNameFormat(t *testing.T) {
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccKeycloakSamlRoleListProtocolMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakSamlRoleListProtocolMapper_nameFormat(clientId, mapperName, "Unknown"),
+				ExpectError: regexp.MustCompile("expected saml_attribute_name_format to be one of"),
+			},
+			{
+				Config:      testKeycloakSamlRoleListProtocolMapper_nameFormat(clientId, mapperName, "URI Reference"),
+				ExpectError: regexp.MustCompile("validation error: saml attribute name .+ must be an absolute uri when the name format is URI Reference"),
+			},
+		},
+	})
+}
+
+func testAccKeycloakSamlRoleListProtocolMapperDestroy() resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		for resourceName, rs := range state.RootModule().Resources {
+			if rs.Type != "keycloak_saml_role_list_protocol_mapper" {
+				continue
+			}
+
+			mapper, _ := getSamlRoleListMapperUsingState(state, resourceName)
+
+			if mapper != nil {
+				return fmt.Errorf("saml role list protocol mapper with id %s still exists", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakSamlRoleListProtocolMapperExists(resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		_, err := getSamlRoleListMapperUsingState(state, resourceName)
+
+		return err
+	}
+}
+
+func getSamlRoleListMapperUsingState(state *terraform.State, resourceName string) (*keycloak.SamlRoleListProtocolMapper, error) {
+	rs, ok := state.RootModule().Resources[resourceName]
+	if !ok {
+		return nil, fmt.Errorf("resource not found in TF state: %s ", resourceName)
+	}
+
+	id := rs.Primary.ID
+	realm := rs.Primary.Attributes["realm_id"]
+	clientId := rs.Primary.Attributes["client_id"]
+	clientScopeId := rs.Primary.Attributes["client_scope_id"]
+
+	return keycloakClient.GetSamlRoleListProtocolMapper(testCtx, realm, clientId, clientScopeId, id)
+}
+
+func testKeycloakSamlRoleListProtocolMapper_import(clientId, clientScopeId, mapperName string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_saml_client" "saml_client" {
+	realm_id  = data.keycloak_realm.realm.id
+	client_id = "%s"
+}
+
+resource "keycloak_saml_role_list_protocol_mapper" "role_list_mapper_client" {
+	name      = "%s"
+	realm_id  = data.keycloak_realm.realm.id
+	client_id = keycloak_saml_client.saml_client.id
+
+	saml_attribute_name = "Role"
+}
+
+resource "keycloak_saml_client_scope" "client_scope" {
+	name     = "%s"
+	realm_id = data.keycloak_realm.realm.id
+}
+
+resource "keycloak_saml_role_list_protocol_mapper" "role_list_mapper_client_scope" {
+	name            = "%s"
+	realm_id        = data.keycloak_realm.realm.id
+	client_scope_id = keycloak_saml_client_scope.client_scope.id
+
+	saml_attribute_name = "Role"
+}`, testAccRealm.Realm, clientId, mapperName, clientScopeId, mapperName)
+}
+
+func testKeycloakSamlRoleListProtocolMapper_singleRoleAttribute(clientId, mapperName string, singleRoleAttribute bool) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_saml_client" "saml_client" {
+	realm_id  = data.keycloak_realm.realm.id
+	client_id = "%s"
+}
+
+resource "keycloak_saml_role_list_protocol_mapper" "role_list_mapper" {
+	name      = "%s"
+	realm_id  = data.keycloak_realm.realm.id
+	client_id = keycloak_saml_client.saml_client.id
+
+	saml_attribute_name   = "Role"
+	single_role_attribute = %t
+}`, testAccRealm.Realm, clientId, mapperName, singleRoleAttribute)
+}
+
+func testKeycloakSamlRoleListProtocolMapper_nameFormat(clientId, mapperName, nameFormat string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_saml_client" "saml_client" {
+	realm_id  = data.keycloak_realm.realm.id
+	client_id = "%s"
+}
+
+resource "keycloak_saml_role_list_protocol_mapper" "role_list_mapper" {
+	name      = "%s"
+	realm_id  = data.keycloak_realm.realm.id
+	client_id = keycloak_saml_client.saml_client.id
+
+	saml_attribute_name        = "Role"
+	saml_attribute_name_format = "%s"
+}`, testAccRealm.Realm, clientId, mapperName, nameFormat)
+}
@@ -0,0 +1,155 @@
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/charlesderek/terraform-w-keycloak/keycloak"
+)
+
+func resourceKeycloakSamlRoleNameProtocolMapper() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKeycloakSamlRoleNameProtocolMapperCreate,
+		ReadContext:   resourceKeycloakSamlRoleNameProtocolMapperRead,
+		UpdateContext: resourceKeycloakSamlRoleNameProtocolMapperUpdate,
+		DeleteContext: resourceKeycloakSamlRoleNameProtocolMapperDelete,
+		Importer: &schema.ResourceImporter{
+			// import a mapper tied to a client:
+			// {{realmId}}/client/{{clientId}}/{{protocolMapperId}}
+			// or a client scope:
+			// {{realmId}}/client-scope/{{clientScopeId}}/{{protocolMapperId}}
+			StateContext: genericProtocolMapperImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "A human-friendly name that will appear in the Keycloak console.",
+			},
+			"realm_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The realm id where the associated client or client scope exists.",
+			},
+			"client_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				Description:   "The mapper's associated client. Cannot be used at the same time as client_scope_id.",
+				ConflictsWith: []string{"client_scope_id"},
+			},
+			"client_scope_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				Description:   "The mapper's associated client scope. Cannot be used at the same time as client_id.",
+				ConflictsWith: []string{"client_id"},
+			},
+			"role_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The id of the role to rename in the assertion.",
+			},
+			"new_role_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name the role is added to the assertion with. Use {{clientId}}.{{roleName}} to add it as a client role.",
+			},
+		},
+	}
+}
+
+func mapFromDataToSamlRoleNameProtocolMapper(data *schema.ResourceData) *keycloak.SamlRoleNameProtocolMapper {
+	return &keycloak.SamlRoleNameProtocolMapper{
+		Id:            data.Id(),
+		Name:          data.Get("name").(string),
+		RealmId:       data.Get("realm_id").(string),
+		ClientId:      data.Get("client_id").(string),
+		ClientScopeId: data.Get("client_scope_id").(string),
+
+		RoleId:      data.Get("role_id").(string),
+		NewRoleName: data.Get("new_role_name").(string),
+	}
+}
+
+func mapFromSamlRoleNameMapperToData(mapper *keycloak.SamlRoleNameProtocolMapper, data *schema.ResourceData) {
+	data.SetId(mapper.Id)
+	data.Set("name", mapper.Name)
+	data.Set("realm_id", mapper.RealmId)
+
+	if mapper.ClientId != "" {
+		data.Set("client_id", mapper.ClientId)
+	} else {
+		data.Set("client_scope_id", mapper.ClientScopeId)
+	}
+
+	data.Set("role_id", mapper.RoleId)
+	data.Set("new_role_name", mapper.NewRoleName)
+}
+
+func resourceKeycloakSamlRoleNameProtocolMapperCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	samlRoleNameMapper := mapFromDataToSamlRoleNameProtocolMapper(data)
+
+	err := keycloakClient.ValidateSamlRoleNameProtocolMapper(ctx, samlRoleNameMapper)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = keycloakClient.NewSamlRoleNameProtocolMapper(ctx, samlRoleNameMapper)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	mapFromSamlRoleNameMapperToData(samlRoleNameMapper, data)
+
+	return resourceKeycloakSamlRoleNameProtocolMapperRead(ctx, data, meta)
+}
+
+func resourceKeycloakSamlRoleNameProtocolMapperRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	clientId := data.Get("client_id").(string)
+	clientScopeId := data.Get("client_scope_id").(string)
+
+	samlRoleNameMapper, err := keycloakClient.GetSamlRoleNameProtocolMapper(ctx, realmId, clientId, clientScopeId, data.Id())
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	mapFromSamlRoleNameMapperToData(samlRoleNameMapper, data)
+
+	return nil
+}
+
+func resourceKeycloakSamlRoleNameProtocolMapperUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	samlRoleNameMapper := mapFromDataToSamlRoleNameProtocolMapper(data)
+
+	err := keycloakClient.ValidateSamlRoleNameProtocolMapper(ctx, samlRoleNameMapper)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = keycloakClient.UpdateSamlRoleNameProtocolMapper(ctx, samlRoleNameMapper)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceKeycloakSamlRoleNameProtocolMapperRead(ctx, data, meta)
+}
+
+func resourceKeycloakSamlRoleNameProtocolMapperDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	clientId := data.Get("client_id").(string)
+	clientScopeId := data.Get("client_scope_id").(string)
+
+	return diag.FromErr(keycloakClient.DeleteSamlRoleNameProtocolMapper(ctx, realmId, clientId, clientScopeId, data.Id()))
+}
@@ -0,0 +1,186 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/charlesderek/terraform-w-keycloak/keycloak"
+)
+
+func TestAccKeycloakSamlRoleNameProtocolMapper_import(t *testing.T) {
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
+	clientScopeId := acctest.RandomWithPrefix("tf-acc")
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+	roleName := acctest.RandomWithPrefix("tf-acc")
+
+	clientResourceName := "keycloak_saml_role_name_protocol_mapper.role_name_mapper_client"
+	clientScopeResourceName := "keycloak_saml_role_name_protocol_mapper.role_name_mapper_client_scope"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccKeycloakSamlRoleNameProtocolMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakSamlRoleNameProtocolMapper_import(clientId, clientScopeId, mapperName, roleName),
+				Check: resource.ComposeTestCheckFunc(
+					testKeycloakSamlRoleNameProtocolMapperExists(clientResourceName),
+					testKeycloakSamlRoleNameProtocolMapperExists(clientScopeResourceName),
+				),
+			},
+			{
+				ResourceName:      clientResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: getGenericProtocolMapperIdForClient(clientResourceName),
+			},
+			{
+				ResourceName:      clientScopeResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: getGenericProtocolMapperIdForClientScope(clientScopeResourceName),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakSamlRoleNameProtocolMapper_update(t *testing.T) {
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+	roleName := acctest.RandomWithPrefix("tf-acc")
+
+	resourceName := "keycloak_saml_role_name_protocol_mapper.role_name_mapper"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccKeycloakSamlRoleNameProtocolMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakSamlRoleNameProtocolMapper_newRoleName(clientId, mapperName, roleName, "renamed"),
+				Check: resource.ComposeTestCheckFunc(
+					testKeycloakSamlRoleNameProtocolMapperExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "new_role_name", "renamed"),
+				),
+			},
+			{
+				Config: testKeycloakSamlRoleNameProtocolMapper_newRoleName(clientId, mapperName, roleName, "renamed-again"),
+				Check: resource.ComposeTestCheckFunc(
+					testKeycloakSamlRoleNameProtocolMapperExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "new_role_name", "renamed-again"),
+				),
+			},
+		},
+	})
+}
+
+func testAccKeycloakSamlRoleNameProtocolMapperDestroy() resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		for resourceName, rs := range state.RootModule().Resources {
+			if rs.Type != "keycloak_saml_role_name_protocol_mapper" {
+				continue
+			}
+
+			mapper, _ := getSamlRoleNameMapperUsingState(state, resourceName)
+
+			if mapper != nil {
+				return fmt.Errorf("saml role name protocol mapper with id %s still exists", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakSamlRoleNameProtocolMapperExists(resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		_, err := getSamlRoleNameMapperUsingState(state, resourceName)
+
+		return err
+	}
+}
+
+func getSamlRoleNameMapperUsingState(state *terraform.State, resourceName string) (*keycloak.SamlRoleNameProtocolMapper, error) {
+	rs, ok := state.RootModule().Resources[resourceName]
+	if !ok {
+		return nil, fmt.Errorf("resource not found in TF state: %s ", resourceName)
+	}
+
+	id := rs.Primary.ID
+	realm := rs.Primary.Attributes["realm_id"]
+	clientId := rs.Primary.Attributes["client_id"]
+	clientScopeId := rs.Primary.Attributes["client_scope_id"]
+
+	return keycloakClient.GetSamlRoleNameProtocolMapper(testCtx, realm, clientId, clientScopeId, id)
+}
+
+func testKeycloakSamlRoleNameProtocolMapper_import(clientId, clientScopeId, mapperName, roleName string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_role" "role" {
+	realm_id = data.keycloak_realm.realm.id
+	name     = "%s"
+}
+
+resource "keycloak_saml_client" "saml_client" {
+	realm_id  = data.keycloak_realm.realm.id
+	client_id = "%s"
+}
+
+resource "keycloak_saml_role_name_protocol_mapper" "role_name_mapper_client" {
+	name      = "%s"
+	realm_id  = data.keycloak_realm.realm.id
+	client_id = keycloak_saml_client.saml_client.id
+
+	role_id       = keycloak_role.role.id
+	new_role_name = "renamed"
+}
+
+resource "keycloak_saml_client_scope" "client_scope" {
+	name     = "%s"
+	realm_id = data.keycloak_realm.realm.id
+}
+
+resource "keycloak_saml_role_name_protocol_mapper" "role_name_mapper_client_scope" {
+	name            = "%s"
+	realm_id        = data.keycloak_realm.realm.id
+	client_scope_id = keycloak_saml_client_scope.client_scope.id
+
+	role_id       = keycloak_role.role.id
+	new_role_name = "renamed"
+}`, testAccRealm.Realm, roleName, clientId, mapperName, clientScopeId, mapperName)
+}
+
+func testKeycloakSamlRoleNameProtocolMapper_newRoleName(clientId, mapperName, roleName, newRoleName string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_role" "role" {
+	realm_id = data.keycloak_realm.realm.id
+	name     = "%s"
+}
+
+resource "keycloak_saml_client" "saml_client" {
+	realm_id  = data.keycloak_realm.realm.id
+	client_id = "%s"
+}
+
+resource "keycloak_saml_role_name_protocol_mapper" "role_name_mapper" {
+	name      = "%s"
+	realm_id  = data.keycloak_realm.realm.id
+	client_id = keycloak_saml_client.saml_client.id
+
+	role_id       = keycloak_role.role.id
+	new_role_name = "%s"
+}`, testAccRealm.Realm, roleName, clientId, mapperName, newRoleName)
+}
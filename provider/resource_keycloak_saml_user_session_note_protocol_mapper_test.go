@@ -0,0 +1,219 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/charlesderek/terraform-w-keycloak/keycloak"
+)
+
+func TestAccKeycloakSamlUserSessionNoteProtocolMapper_import(t *testing.T) {
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
+	clientScopeId := acctest.RandomWithPrefix("tf-acc")
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+
+	clientResourceName := "keycloak_saml_user_session_note_protocol_mapper.user_session_note_mapper_client"
+	clientScopeResourceName := "keycloak_saml_user_session_note_protocol_mapper.user_session_note_mapper_client_scope"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccKeycloakSamlUserSessionNoteProtocolMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakSamlUserSessionNoteProtocolMapper_import(clientId, clientScopeId, mapperName),
+				Check: resource.ComposeTestCheckFunc(
+					testKeycloakSamlUserSessionNoteProtocolMapperExists(clientResourceName),
+					testKeycloakSamlUserSessionNoteProtocolMapperExists(clientScopeResourceName),
+				),
+			},
+			{
+				ResourceName:      clientResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: getGenericProtocolMapperIdForClient(clientResourceName),
+			},
+			{
+				ResourceName:      clientScopeResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: getGenericProtocolMapperIdForClientScope(clientScopeResourceName),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakSamlUserSessionNoteProtocolMapper_update(t *testing.T) {
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+
+	resourceName := "keycloak_saml_user_session_note_protocol_mapper.user_session_note_mapper"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccKeycloakSamlUserSessionNoteProtocolMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakSamlUserSessionNoteProtocolMapper_sessionNote(clientId, mapperName, "bar"),
+				Check: resource.ComposeTestCheckFunc(
+					testKeycloakSamlUserSessionNoteProtocolMapperExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "session_note", "bar"),
+				),
+			},
+			{
+				Config: testKeycloakSamlUserSessionNoteProtocolMapper_sessionNote(clientId, mapperName, "baz"),
+				Check: resource.ComposeTestCheckFunc(
+					testKeycloakSamlUserSessionNoteProtocolMapperExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "session_note", "baz"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakSamlUserSessionNoteProtocolMapper_validateNameFormat(t *testing.T) {
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccKeycloakSamlUserSessionNoteProtocolMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakSamlUserSessionNoteProtocolMapper_nameFormat(clientId, mapperName, "Unknown"),
+				ExpectError: regexp.MustCompile("expected saml_attribute_name_format to be one of"),
+			},
+			{
+				Config:      testKeycloakSamlUserSessionNoteProtocolMapper_nameFormat(clientId, mapperName, "URI Reference"),
+				ExpectError: regexp.MustCompile("validation error: saml attribute name .+ must be an absolute uri when the name format is URI Reference"),
+			},
+		},
+	})
+}
+
+func testAccKeycloakSamlUserSessionNoteProtocolMapperDestroy() resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		for resourceName, rs := range state.RootModule().Resources {
+			if rs.Type != "keycloak_saml_user_session_note_protocol_mapper" {
+				continue
+			}
+
+			mapper, _ := getSamlUserSessionNoteMapperUsingState(state, resourceName)
+
+			if mapper != nil {
+				return fmt.Errorf("saml user session note protocol mapper with id %s still exists", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakSamlUserSessionNoteProtocolMapperExists(resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		_, err := getSamlUserSessionNoteMapperUsingState(state, resourceName)
+
+		return err
+	}
+}
+
+func getSamlUserSessionNoteMapperUsingState(state *terraform.State, resourceName string) (*keycloak.SamlUserSessionNoteProtocolMapper, error) {
+	rs, ok := state.RootModule().Resources[resourceName]
+	if !ok {
+		return nil, fmt.Errorf("resource not found in TF state: %s ", resourceName)
+	}
+
+	id := rs.Primary.ID
+	realm := rs.Primary.Attributes["realm_id"]
+	clientId := rs.Primary.Attributes["client_id"]
+	clientScopeId := rs.Primary.Attributes["client_scope_id"]
+
+	return keycloakClient.GetSamlUserSessionNoteProtocolMapper(testCtx, realm, clientId, clientScopeId, id)
+}
+
+func testKeycloakSamlUserSessionNoteProtocolMapper_import(clientId, clientScopeId, mapperName string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_saml_client" "saml_client" {
+	realm_id  = data.keycloak_realm.realm.id
+	client_id = "%s"
+}
+
+resource "keycloak_saml_user_session_note_protocol_mapper" "user_session_note_mapper_client" {
+	name      = "%s"
+	realm_id  = data.keycloak_realm.realm.id
+	client_id = keycloak_saml_client.saml_client.id
+
+	saml_attribute_name = "foo"
+	session_note        = "bar"
+}
+
+resource "keycloak_saml_client_scope" "client_scope" {
+	name     = "%s"
+	realm_id = data.keycloak_realm.realm.id
+}
+
+resource "keycloak_saml_user_session_note_protocol_mapper" "user_session_note_mapper_client_scope" {
+	name            = "%s"
+	realm_id        = data.keycloak_realm.realm.id
+	client_scope_id = keycloak_saml_client_scope.client_scope.id
+
+	saml_attribute_name = "foo"
+	session_note        = "bar"
+}`, testAccRealm.Realm, clientId, mapperName, clientScopeId, mapperName)
+}
+
+func testKeycloakSamlUserSessionNoteProtocolMapper_sessionNote(clientId, mapperName, sessionNote string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_saml_client" "saml_client" {
+	realm_id  = data.keycloak_realm.realm.id
+	client_id = "%s"
+}
+
+resource "keycloak_saml_user_session_note_protocol_mapper" "user_session_note_mapper" {
+	name      = "%s"
+	realm_id  = data.keycloak_realm.realm.id
+	client_id = keycloak_saml_client.saml_client.id
+
+	saml_attribute_name = "foo"
+	session_note        = "%s"
+}`, testAccRealm.Realm, clientId, mapperName, sessionNote)
+}
+
+func testKeycloakSamlUserSessionNoteProtocolMapper_nameFormat(clientId, mapperName, nameFormat string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_saml_client" "saml_client" {
+	realm_id  = data.keycloak_realm.realm.id
+	client_id = "%s"
+}
+
+resource "keycloak_saml_user_session_note_protocol_mapper" "user_session_note_mapper" {
+	name      = "%s"
+	realm_id  = data.keycloak_realm.realm.id
+	client_id = keycloak_saml_client.saml_client.id
+
+	saml_attribute_name        = "foo"
+	session_note               = "bar"
+	saml_attribute_name_format = "%s"
+}`, testAccRealm.Realm, clientId, mapperName, nameFormat)
+}
@@ -0,0 +1,26 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/charlesderek/terraform-w-keycloak/keycloak"
+)
+
+func resourceKeycloakStackoverflowIdentityProvider() *schema.Resource {
+	stackoverflowSchema := map[string]*schema.Schema{
+		"key": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The Stack Overflow application key.",
+		},
+	}
+
+	return resourceKeycloakSocialIdentityProvider("stackoverflow", stackoverflowSchema, getStackoverflowIdentityProviderConfigFromData, setStackoverflowIdentityProviderConfigData)
+}
+
+func getStackoverflowIdentityProviderConfigFromData(data *schema.ResourceData, config *keycloak.IdentityProviderConfig) {
+	config.Key = data.Get("key").(string)
+}
+
+func setStackoverflowIdentityProviderConfigData(data *schema.ResourceData, config *keycloak.IdentityProviderConfig) {
+	data.Set("key", config.Key)
+}